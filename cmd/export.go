@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	noncecounter "github.com/rem1niscence/ssv-nounce-counter/nonce_counter"
+)
+
+// export syncs the nonces of the configured addresses up to the chain head and writes them, along with the history of
+// their increments, to files in the chosen format. It also writes a snapshot other runs can bootstrap from, unless the
+// sync started after the contract deployment, returning the process exit code.
+func export(ctx context.Context, config noncecounter.Config, args []string) int {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	formatName := flags.String("format", "json", "export format: json, jsonl or csv")
+	outDir := flags.String("out", ".", "directory to write nonces.<format>, history.<format> and snapshot.json to")
+	snapshotKey := flags.String("snapshot-key", "", "file holding the hex private key to sign the snapshot with, it is only hashed when not set")
+	flags.Parse(args)
+
+	format, err := noncecounter.ParseExportFormat(*formatName)
+	if err != nil {
+		slog.Error("invalid export format", "error", err)
+		flags.Usage()
+		return 2
+	}
+
+	var key *ecdsa.PrivateKey
+	if *snapshotKey != "" {
+		if key, err = crypto.LoadECDSA(*snapshotKey); err != nil {
+			slog.Error("failed to load snapshot key", "error", err)
+			return 1
+		}
+	}
+
+	ncCounter, err := noncecounter.NewNonceCounter(config)
+	if err != nil {
+		slog.Error("failed to create nonce counter", "error", err)
+		return 1
+	}
+
+	slog.Info("syncing owner nonces", "from_block", uint64(config.StartBlock))
+	block, err := ncCounter.Sync(ctx, uint64(config.StartBlock), rpcURL)
+	if err != nil {
+		slog.Error("failed to sync owner nonces", "error", err)
+		return 1
+	}
+	if ctx.Err() != nil {
+		slog.Error("interrupted before reaching the chain head")
+		return 1
+	}
+
+	noncesPath := filepath.Join(*outDir, "nonces."+string(format))
+	if err := writeExport(noncesPath, format, ncCounter.ExportNonces); err != nil {
+		slog.Error("failed to export nonces", "error", err)
+		return 1
+	}
+	historyPath := filepath.Join(*outDir, "history."+string(format))
+	if err := writeExport(historyPath, format, ncCounter.ExportHistory); err != nil {
+		slog.Error("failed to export nonce history", "error", err)
+		return 1
+	}
+
+	snapshot, err := ncCounter.TakeSnapshot(ctx, rpcURL)
+	if errors.Is(err, noncecounter.ErrIncompleteNonces) {
+		// Nonces counted since --since are not the owners' nonces, other runs must not bootstrap from them
+		slog.Warn("not writing a snapshot, the nonces only count registrations since the start block",
+			"from_block", uint64(config.StartBlock), "deployment_block", config.DeploymentBlock)
+		fmt.Printf("exported nonces at block %d to %s and %s\n", block, noncesPath, historyPath)
+		return 0
+	}
+	if err != nil {
+		slog.Error("failed to take snapshot", "error", err)
+		return 1
+	}
+	if key != nil {
+		if err := snapshot.Sign(key); err != nil {
+			slog.Error("failed to sign snapshot", "error", err)
+			return 1
+		}
+	}
+	snapshotPath := filepath.Join(*outDir, "snapshot.json")
+	if err := noncecounter.WriteSnapshot(snapshotPath, snapshot); err != nil {
+		slog.Error("failed to write snapshot", "error", err)
+		return 1
+	}
+	fmt.Printf("exported nonces at block %d to %s, %s and %s\n", block, noncesPath, historyPath, snapshotPath)
+	return 0
+}
+
+// writeExport creates the file at path and writes an export to it.
+func writeExport(path string, format noncecounter.ExportFormat, write func(w io.Writer, format noncecounter.ExportFormat) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(file, format); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -2,12 +2,21 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	noncecounter "github.com/rem1niscence/ssv-nounce-counter/nonce_counter"
+	"google.golang.org/grpc"
 )
 
 // On a production environment these values would be supplied in a more programmatic way
@@ -33,22 +42,285 @@ var (
 )
 
 func main() {
+	abiPath := flag.String("abi", "", "path to the contract ABI, either plain, a compiler artifact or an Etherscan getabi response (defaults to the bundled SSVNetwork ABI)")
+	warnRunwayBlocks := flag.Uint64("warn-runway-blocks", 50400, "warn when a tracked owner's cluster can be liquidated within this many blocks (defaults to about a week)")
+	verifyShares := flag.Bool("verify-shares", false, "verify that the shares signature of every tracked owner's registration signs the counted nonce")
+	pendingNonces := flag.Bool("pending", false, "also report the nonces owners will have once the registrations waiting in the pending block are included")
+	attributeSubmissions := flag.Bool("attribute-submissions", false, "fetch the transaction of every tracked owner's registration and print which call, sender and batch size caused each nonce increment")
+	blockTimestamps := flag.Bool("timestamps", false, "fetch the block timestamp of every nonce increment")
+	since := flag.String("since", "", "only scan blocks from this RFC 3339 time on, nonces then count the registrations since then only")
+	until := flag.String("until", "", "stop after the last block up to this RFC 3339 time instead of following the chain head")
+	snapshotPath := flag.String("snapshot", "", "bootstrap the nonces from a snapshot file written by export, resuming the scan after its block")
+	snapshotSigner := flag.String("snapshot-signer", "", "only accept snapshots signed by this address")
+	recordPath := flag.String("record", "", "append every log fetched from the RPC to this JSONL file, for the replay command")
+	grpcAddr := flag.String("grpc-addr", "", "serve the NonceCounter gRPC service on this address, e.g. localhost:9090")
+	httpAddr := flag.String("http-addr", "", "serve the nonce updates as Server-Sent Events on /events at this address, e.g. localhost:8080")
+	webhookURL := flag.String("webhook-url", "", "POST nonce increments, sync stalls and decode errors to this URL, signed with the WEBHOOK_SECRET environment variable")
+	webhookOutbox := flag.String("webhook-outbox", "webhook_outbox.json", "file keeping the webhooks not delivered yet across restarts")
+	publishTarget := flag.String("publish", "", "publish every decoded ValidatorAdded event, keyed by owner, to stdout, a NATS server (nats://host:port) or a JSONL file")
+	publishCheckpoint := flag.String("publish-checkpoint", "publisher_checkpoint.json", "file keeping the last block whose events were published, so restarts only republish unacknowledged events")
+	natsSubject := flag.String("nats-subject", noncecounter.DefaultNATSSubject, "subject prefix of the events published to NATS, followed by the owner address")
+	natsJetStream := flag.Bool("nats-jetstream", false, "publish to a JetStream stream and wait for its acknowledgments")
+	logFormat := flag.String("log-format", "text", "format of the logs written to stderr, text or json")
+	logLevel := flag.String("log-level", "info", "minimum level of the logs, debug, info, warn or error")
+	otlpEndpoint := flag.String("otlp-endpoint", "", "export traces of the scanned block ranges, RPC calls and log decoding to this OTLP gRPC collector, e.g. localhost:4317")
+	otlpInsecure := flag.Bool("otlp-insecure", false, "connect to the OTLP collector without TLS")
+	sqlitePath := flag.String("sqlite", "", "write every ValidatorAdded event and the tracked nonces to this SQLite database, resuming the scan after its checkpoint")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [verify-keyshares -file keyshares.json | export -format json|jsonl|csv -out dir -snapshot-key key | replay -file logs.jsonl]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	logger, err := newLogger(*logFormat, *logLevel)
+	if err != nil {
+		slog.Error("invalid logging flags", "error", err)
+		os.Exit(2)
+	}
+	slog.SetDefault(logger)
+
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
-	ncCounter, err := noncecounter.NewNonceCounter(noncecounter.Config{
-		ContractAddress: contractAddress,
-		EventName:       eventName,
-		ContractABI:     contractABIJSON,
-		Addresses:       addresses,
-		BlockBatchSize:  blockBatchSize,
-		Concurrency:     concurrency,
-	})
+	tracerProvider, shutdownTracing, err := newTracerProvider(ctx, *otlpEndpoint, *otlpInsecure)
+	if err != nil {
+		slog.Error("failed to set up tracing", "error", err)
+		os.Exit(2)
+	}
+	// exit flushes the spans still buffered before exiting, deferred calls do not run on os.Exit
+	exit := func(code int) {
+		flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(flushCtx); err != nil {
+			slog.Error("failed to flush traces", "error", err)
+		}
+		os.Exit(code)
+	}
+
+	config := noncecounter.Config{
+		ContractAddress:      contractAddress,
+		EventName:            eventName,
+		ContractABIPath:      *abiPath,
+		StartBlock:           startBlock,
+		DeploymentBlock:      startBlock,
+		Addresses:            addresses,
+		BlockBatchSize:       blockBatchSize,
+		Concurrency:          concurrency,
+		VerifyShares:         *verifyShares,
+		PendingNonces:        *pendingNonces,
+		AttributeSubmissions: *attributeSubmissions,
+		BlockTimestamps:      *blockTimestamps,
+		SnapshotPath:         *snapshotPath,
+		SnapshotSigner:       *snapshotSigner,
+		RecordPath:           *recordPath,
+		Logger:               logger,
+		TracerProvider:       tracerProvider,
+	}
+	if config.ContractABIPath == "" {
+		config.ContractABI = contractABIJSON
+	}
+
+	switch command := flag.Arg(0); command {
+	case "":
+		if err := resolveTimeRange(ctx, &config, *since, *until); err != nil {
+			slog.Error("failed to resolve time range", "error", err)
+			exit(1)
+		}
+		run(ctx, config, runOptions{
+			warnRunwayBlocks:  *warnRunwayBlocks,
+			sqlitePath:        *sqlitePath,
+			grpcAddr:          *grpcAddr,
+			httpAddr:          *httpAddr,
+			webhookURL:        *webhookURL,
+			webhookOutbox:     *webhookOutbox,
+			publishTarget:     *publishTarget,
+			publishCheckpoint: *publishCheckpoint,
+			natsSubject:       *natsSubject,
+			natsJetStream:     *natsJetStream,
+		})
+		exit(0)
+	case "verify-keyshares":
+		exit(verifyKeyShares(ctx, config, flag.Args()[1:]))
+	case "export":
+		if err := resolveTimeRange(ctx, &config, *since, *until); err != nil {
+			slog.Error("failed to resolve time range", "error", err)
+			exit(1)
+		}
+		exit(export(ctx, config, flag.Args()[1:]))
+	case "replay":
+		exit(replay(ctx, config, flag.Args()[1:]))
+	default:
+		slog.Error("unknown command", "command", command)
+		flag.Usage()
+		exit(2)
+	}
+}
+
+// runOptions are the settings of run that are not part of the counter configuration.
+type runOptions struct {
+	warnRunwayBlocks  uint64
+	sqlitePath        string
+	grpcAddr          string
+	httpAddr          string
+	webhookURL        string
+	webhookOutbox     string
+	publishTarget     string
+	publishCheckpoint string
+	natsSubject       string
+	natsJetStream     bool
+}
+
+// run follows the chain tracking the nonces, clusters and operators of the configured addresses until interrupted.
+// Clusters and operators are only tracked when the scan starts at the contract deployment.
+func run(ctx context.Context, config noncecounter.Config, opts runOptions) {
+	// Events published to stdout are JSON lines for another process, the nonce reports move to stderr out of their way
+	reports := io.Writer(os.Stdout)
+	if opts.publishTarget == "stdout" {
+		reports = os.Stderr
+	}
+	config.Output = reports
+	ncCounter, err := noncecounter.NewNonceCounter(config)
 	if err != nil {
 		panic(fmt.Sprintf("failed to create nonce counter: %v", err))
 	}
 
-	fmt.Println("starting nonce counter...")
-	ncCounter.Start(ctx, startBlock, rpcURL)
-	fmt.Println("nonce counter stopped, exiting...")
+	owners := make([]common.Address, 0, len(config.Addresses))
+	for _, address := range config.Addresses {
+		owners = append(owners, common.HexToAddress(address))
+	}
+
+	fromBlock := uint64(config.StartBlock)
+	if opts.sqlitePath != "" {
+		store, err := noncecounter.OpenSQLStore(opts.sqlitePath)
+		if err != nil {
+			panic(fmt.Sprintf("failed to open SQL store: %v", err))
+		}
+		defer store.Close()
+		resumeBlock, resumed, err := store.Attach(ncCounter)
+		if err != nil {
+			panic(fmt.Sprintf("failed to attach SQL store: %v", err))
+		}
+		if resumed && resumeBlock > fromBlock {
+			slog.Info("resuming from SQL store checkpoint", "block", resumeBlock-1)
+			fromBlock = resumeBlock
+		}
+	}
+
+	// Clusters, operators and liquidations are not restored from snapshots or checkpoints, they need every event since
+	// the deployment
+	var clusters *noncecounter.ClusterTracker
+	var operators *noncecounter.OperatorRegistry
+	if config.SnapshotPath != "" || fromBlock > config.DeploymentBlock {
+		slog.Warn("not tracking clusters, operators and liquidations, the scan does not start at the contract deployment",
+			"from_block", fromBlock, "deployment_block", config.DeploymentBlock, "snapshot", config.SnapshotPath != "")
+	} else {
+		clusters, operators = trackClusters(ncCounter, owners, opts.warnRunwayBlocks)
+	}
+
+	if opts.grpcAddr != "" {
+		listener, err := net.Listen("tcp", opts.grpcAddr)
+		if err != nil {
+			panic(fmt.Sprintf("failed to listen on %s: %v", opts.grpcAddr, err))
+		}
+		server := grpc.NewServer()
+		noncecounter.NewGRPCServer(ncCounter).Register(server)
+		go func() {
+			if err := server.Serve(listener); err != nil {
+				slog.Error("gRPC server failed", "error", err)
+			}
+		}()
+		defer server.Stop()
+		slog.Info("serving gRPC", "addr", listener.Addr().String())
+	}
+
+	if opts.httpAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/events", noncecounter.NewEventStream(ncCounter, noncecounter.DefaultHeartbeatInterval))
+		server := &http.Server{Addr: opts.httpAddr, Handler: mux}
+		go func() {
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("HTTP server failed", "error", err)
+			}
+		}()
+		defer server.Close()
+		slog.Info("serving nonce events", "url", "http://"+opts.httpAddr+"/events")
+	}
+
+	if opts.webhookURL != "" {
+		notifier, err := noncecounter.NewWebhookNotifier(noncecounter.WebhookConfig{
+			URL:        opts.webhookURL,
+			Secret:     os.Getenv("WEBHOOK_SECRET"),
+			OutboxPath: opts.webhookOutbox,
+		})
+		if err != nil {
+			panic(fmt.Sprintf("failed to create webhook notifier: %v", err))
+		}
+		notifier.Attach(ncCounter)
+		go notifier.Run(ctx)
+	}
+
+	if opts.publishTarget != "" {
+		publisher, err := newPublisher(opts.publishTarget, opts.natsSubject, opts.natsJetStream)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create publisher: %v", err))
+		}
+		defer publisher.Close()
+		eventPublisher, err := noncecounter.NewEventPublisher(publisher, opts.publishCheckpoint)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create event publisher: %v", err))
+		}
+		if err := eventPublisher.Attach(ncCounter); err != nil {
+			panic(fmt.Sprintf("failed to attach event publisher: %v", err))
+		}
+	}
+
+	slog.Info("starting nonce counter", "from_block", fromBlock)
+	if err := ncCounter.Start(ctx, fromBlock, rpcURL); err != nil {
+		slog.Error("nonce counter failed", "error", err)
+	}
+	if clusters != nil {
+		printOwnerOperators(reports, owners, clusters, operators)
+	}
+	slog.Info("nonce counter stopped, exiting")
+}
+
+// trackClusters registers a cluster tracker, an operator registry and a liquidation monitor of owners on nc, warning
+// about the clusters that can be liquidated within warnRunwayBlocks of the chain head.
+func trackClusters(nc *noncecounter.NonceCounter, owners []common.Address, warnRunwayBlocks uint64) (*noncecounter.ClusterTracker, *noncecounter.OperatorRegistry) {
+	clusters := noncecounter.NewClusterTracker()
+	operators := noncecounter.NewOperatorRegistry()
+	liquidations := noncecounter.NewLiquidationMonitor(clusters, operators, noncecounter.LiquidationConfig{
+		Owners:           owners,
+		WarnRunwayBlocks: warnRunwayBlocks,
+	})
+	if err := clusters.Register(nc.Registry()); err != nil {
+		panic(fmt.Sprintf("failed to register cluster tracker: %v", err))
+	}
+	if err := operators.Register(nc.Registry()); err != nil {
+		panic(fmt.Sprintf("failed to register operator registry: %v", err))
+	}
+	if err := liquidations.Register(nc.Registry()); err != nil {
+		panic(fmt.Sprintf("failed to register liquidation monitor: %v", err))
+	}
+	nc.OnBatch(func(batch noncecounter.Batch) {
+		// Runways during a backfill are history, only the ones at the chain head are worth a warning
+		if batch.AtHead {
+			liquidations.Check(batch.ToBlock)
+		}
+	})
+	return clusters, operators
+}
+
+// printOwnerOperators prints the operators running validators of every tracked owner to out.
+func printOwnerOperators(out io.Writer, owners []common.Address, clusters *noncecounter.ClusterTracker, operators *noncecounter.OperatorRegistry) {
+	fmt.Fprintln(out, "-----------------------------------------")
+	fmt.Fprintln(out, "Operators used by tracked owners:")
+	for _, owner := range owners {
+		ids := noncecounter.OperatorIDs(clusters.Clusters(owner))
+		fmt.Fprintf(out, "Address: %s, Operators: %v\n", owner.Hex(), ids)
+		for _, op := range operators.Operators(ids) {
+			fmt.Fprintf(out, "  Operator %d: Owner: %s, Fee: %s, Private: %t, Removed: %t\n", op.ID, op.Owner.Hex(), op.Fee, op.Private, op.Removed)
+		}
+	}
+	fmt.Fprintln(out, "-----------------------------------------")
 }
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"os"
+	"strings"
+
+	noncecounter "github.com/rem1niscence/ssv-nounce-counter/nonce_counter"
+)
+
+// newPublisher returns the publisher of target: "stdout", a nats:// or tls:// server URL, or a file path.
+func newPublisher(target, natsSubject string, jetStream bool) (noncecounter.Publisher, error) {
+	switch {
+	case target == "stdout":
+		return noncecounter.NewWriterPublisher(os.Stdout), nil
+	case strings.HasPrefix(target, "nats://") || strings.HasPrefix(target, "tls://"):
+		return noncecounter.NewNATSPublisher(noncecounter.NATSConfig{URL: target, Subject: natsSubject, JetStream: jetStream})
+	default:
+		return noncecounter.NewFilePublisher(target)
+	}
+}
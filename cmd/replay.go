@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"path/filepath"
+
+	noncecounter "github.com/rem1niscence/ssv-nounce-counter/nonce_counter"
+)
+
+// replay counts the nonces of the configured addresses from a log recording instead of the RPC, optionally exporting
+// the result, and returns the process exit code.
+func replay(ctx context.Context, config noncecounter.Config, args []string) int {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	path := flags.String("file", "", "path to the JSONL log recording written with -record or dumped from eth_getLogs")
+	formatName := flags.String("format", "", "also export nonces.<format> and history.<format>: json, jsonl or csv")
+	outDir := flags.String("out", ".", "directory to write the exports to")
+	flags.Parse(args)
+
+	if *path == "" {
+		slog.Error("a log recording must be provided with -file")
+		flags.Usage()
+		return 2
+	}
+	var format noncecounter.ExportFormat
+	if *formatName != "" {
+		var err error
+		if format, err = noncecounter.ParseExportFormat(*formatName); err != nil {
+			slog.Error("invalid export format", "error", err)
+			flags.Usage()
+			return 2
+		}
+	}
+
+	// Replays never append to the recording they read
+	config.RecordPath = ""
+	ncCounter, err := noncecounter.NewNonceCounter(config)
+	if err != nil {
+		slog.Error("failed to create nonce counter", "error", err)
+		return 1
+	}
+	if err := ncCounter.ReplayFile(ctx, *path); err != nil {
+		slog.Error("failed to replay logs", "error", err)
+		return 1
+	}
+
+	if format == "" {
+		return 0
+	}
+	if err := writeExport(filepath.Join(*outDir, "nonces."+string(format)), format, ncCounter.ExportNonces); err != nil {
+		slog.Error("failed to export nonces", "error", err)
+		return 1
+	}
+	if err := writeExport(filepath.Join(*outDir, "history."+string(format)), format, ncCounter.ExportHistory); err != nil {
+		slog.Error("failed to export nonce history", "error", err)
+		return 1
+	}
+	return 0
+}
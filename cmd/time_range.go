@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	noncecounter "github.com/rem1niscence/ssv-nounce-counter/nonce_counter"
+)
+
+// resolveTimeRange sets the start and until blocks of config from RFC 3339 since and until times, either of which may
+// be empty to keep the default.
+func resolveTimeRange(ctx context.Context, config *noncecounter.Config, since, until string) error {
+	if since == "" && until == "" {
+		return nil
+	}
+
+	client, err := rpc.DialContext(ctx, rpcURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	clock := noncecounter.NewBlockClock(client)
+
+	if since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return fmt.Errorf("invalid since time: %w", err)
+		}
+		block, err := clock.BlockAt(ctx, t)
+		if err != nil {
+			return err
+		}
+		config.StartBlock = int64(block)
+	}
+
+	if until != "" {
+		t, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return fmt.Errorf("invalid until time: %w", err)
+		}
+		// An until time at or after the chain head stops at the head
+		block, err := clock.BlockUntil(ctx, t)
+		if err != nil {
+			return err
+		}
+		config.UntilBlock = block
+	}
+
+	if config.UntilBlock != 0 {
+		slog.Info("resolved time range", "from_block", config.StartBlock, "to_block", config.UntilBlock)
+	} else {
+		slog.Info("resolved time range", "from_block", config.StartBlock)
+	}
+	return config.Validate()
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"context"
+
+	noncecounter "github.com/rem1niscence/ssv-nounce-counter/nonce_counter"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// newTracerProvider returns a tracer provider exporting to the OTLP gRPC collector at endpoint, or discarding spans
+// when endpoint is empty, along with the function flushing it.
+func newTracerProvider(ctx context.Context, endpoint string, insecure bool) (trace.TracerProvider, func(context.Context) error, error) {
+	if endpoint == "" {
+		return noop.NewTracerProvider(), func(context.Context) error { return nil }, nil
+	}
+	provider, err := noncecounter.NewOTLPTracerProvider(ctx, noncecounter.OTLPConfig{Endpoint: endpoint, Insecure: insecure})
+	if err != nil {
+		return nil, nil, err
+	}
+	return provider, provider.Shutdown, nil
+}
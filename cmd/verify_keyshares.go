@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+
+	noncecounter "github.com/rem1niscence/ssv-nounce-counter/nonce_counter"
+)
+
+// verifyKeyShares syncs the nonces of the owners of a keyshares file up to the chain head and reports the entries
+// whose nonce would not be accepted, returning the process exit code.
+func verifyKeyShares(ctx context.Context, config noncecounter.Config, args []string) int {
+	flags := flag.NewFlagSet("verify-keyshares", flag.ExitOnError)
+	path := flags.String("file", "", "path to the keyshares.json file generated by ssv-keys")
+	flags.Parse(args)
+
+	if *path == "" {
+		slog.Error("a keyshares file must be provided with -file")
+		flags.Usage()
+		return 2
+	}
+
+	file, err := noncecounter.LoadKeySharesFile(*path)
+	if err != nil {
+		slog.Error("failed to load keyshares file", "error", err)
+		return 1
+	}
+
+	// Only the owners in the file matter, whatever addresses are configured
+	config.Addresses = nil
+	for _, entry := range file.Shares {
+		config.Addresses = append(config.Addresses, entry.Data.OwnerAddress.Hex())
+	}
+
+	ncCounter, err := noncecounter.NewNonceCounter(config)
+	if err != nil {
+		slog.Error("failed to create nonce counter", "error", err)
+		return 1
+	}
+
+	slog.Info("syncing owner nonces", "from_block", startBlock)
+	block, err := ncCounter.Sync(ctx, startBlock, rpcURL)
+	if err != nil {
+		slog.Error("failed to sync owner nonces", "error", err)
+		return 1
+	}
+	if ctx.Err() != nil {
+		slog.Error("interrupted before reaching the chain head")
+		return 1
+	}
+
+	issues := ncCounter.VerifyKeyShares(file)
+	fmt.Printf("verified %d shares against nonces at block %d\n", len(file.Shares), block)
+	for _, issue := range issues {
+		fmt.Println(issue)
+	}
+	if len(issues) > 0 {
+		fmt.Printf("found %d issues\n", len(issues))
+		return 1
+	}
+	fmt.Println("all shares use the expected nonces")
+	return 0
+}
@@ -0,0 +1,81 @@
+package noncecounter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// ErrNotRegistration is returned by DecodeRegistrationCall for calldata of other contract functions.
+var ErrNotRegistration = errors.New("calldata is not a validator registration")
+
+// RegistrationCall is a decoded registerValidator or bulkRegisterValidator call. Every validator it registers
+// consumes the next nonce of the sender, in PublicKeys order.
+type RegistrationCall struct {
+	// Method is the name of the called contract function.
+	Method      string
+	PublicKeys  [][]byte
+	OperatorIds []uint64
+	// SharesData holds the shares payload of every validator, in PublicKeys order.
+	SharesData [][]byte
+}
+
+// DecodeRegistrationCall decodes the calldata of a transaction to the contract, which must be a registerValidator or
+// bulkRegisterValidator call.
+func DecodeRegistrationCall(contractAbi abi.ABI, input []byte) (RegistrationCall, error) {
+	if len(input) < 4 {
+		return RegistrationCall{}, fmt.Errorf("%w: calldata is %d bytes long", ErrNotRegistration, len(input))
+	}
+	method, err := contractAbi.MethodById(input[:4])
+	if err != nil {
+		return RegistrationCall{}, fmt.Errorf("%w: %v", ErrNotRegistration, err)
+	}
+
+	switch method.Name {
+	case "registerValidator", "bulkRegisterValidator":
+	default:
+		return RegistrationCall{}, fmt.Errorf("%w: %s call", ErrNotRegistration, method.Name)
+	}
+
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return RegistrationCall{}, fmt.Errorf("failed to decode %s calldata: %w", method.Name, err)
+	}
+	unpacked := map[string]any{}
+	for i, arg := range method.Inputs {
+		unpacked[arg.Name] = values[i]
+	}
+
+	var ok bool
+	call := RegistrationCall{Method: method.Name}
+	if call.OperatorIds, ok = unpacked["operatorIds"].([]uint64); !ok {
+		return RegistrationCall{}, fmt.Errorf("%s calldata has no operatorIds argument", method.Name)
+	}
+
+	if method.Name == "registerValidator" {
+		publicKey, ok := unpacked["publicKey"].([]byte)
+		if !ok {
+			return RegistrationCall{}, fmt.Errorf("%s calldata has no publicKey argument", method.Name)
+		}
+		sharesData, ok := unpacked["sharesData"].([]byte)
+		if !ok {
+			return RegistrationCall{}, fmt.Errorf("%s calldata has no sharesData argument", method.Name)
+		}
+		call.PublicKeys = [][]byte{publicKey}
+		call.SharesData = [][]byte{sharesData}
+		return call, nil
+	}
+
+	if call.PublicKeys, ok = unpacked["publicKeys"].([][]byte); !ok {
+		return RegistrationCall{}, fmt.Errorf("%s calldata has no publicKeys argument", method.Name)
+	}
+	if call.SharesData, ok = unpacked["sharesData"].([][]byte); !ok {
+		return RegistrationCall{}, fmt.Errorf("%s calldata has no sharesData argument", method.Name)
+	}
+	if len(call.PublicKeys) != len(call.SharesData) {
+		return RegistrationCall{}, fmt.Errorf("%s calldata has %d public keys but %d shares", method.Name,
+			len(call.PublicKeys), len(call.SharesData))
+	}
+	return call, nil
+}
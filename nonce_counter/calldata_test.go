@@ -0,0 +1,128 @@
+package noncecounter
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// packRegistration builds registerValidator calldata for a single public key, or bulkRegisterValidator calldata
+// when bulk is set.
+func packRegistration(t testing.TB, contractAbi abi.ABI, bulk bool, publicKeys ...[]byte) []byte {
+	t.Helper()
+
+	operatorIds := []uint64{1, 2, 3, 4}
+	cluster := ISSVNetworkCoreCluster{Balance: new(big.Int), Index: 0, NetworkFeeIndex: 0, Active: true}
+	var input []byte
+	var err error
+	if bulk {
+		sharesData := make([][]byte, len(publicKeys))
+		for i, publicKey := range publicKeys {
+			sharesData[i] = append([]byte{0x5a}, publicKey...)
+		}
+		input, err = contractAbi.Pack("bulkRegisterValidator", publicKeys, operatorIds, sharesData, big.NewInt(1), cluster)
+	} else {
+		input, err = contractAbi.Pack("registerValidator", publicKeys[0], operatorIds, append([]byte{0x5a}, publicKeys[0]...), big.NewInt(1), cluster)
+	}
+	if err != nil {
+		t.Fatalf("failed to pack registration: %v", err)
+	}
+	return input
+}
+
+func TestDecodeRegistrationCall(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	firstKey := bytes.Repeat([]byte{0x01}, 48)
+	secondKey := bytes.Repeat([]byte{0x02}, 48)
+
+	removeValidator, err := contractAbi.Pack("removeValidator", firstKey, []uint64{1, 2, 3, 4},
+		ISSVNetworkCoreCluster{Balance: new(big.Int)})
+	if err != nil {
+		t.Fatalf("failed to pack removeValidator: %v", err)
+	}
+	registration := packRegistration(t, contractAbi, false, firstKey)
+
+	tests := []struct {
+		name                string
+		input               []byte
+		wantMethod          string
+		wantPublicKeys      [][]byte
+		wantErr             bool
+		wantNotRegistration bool
+	}{
+		{
+			name:           "registerValidator",
+			input:          registration,
+			wantMethod:     "registerValidator",
+			wantPublicKeys: [][]byte{firstKey},
+		},
+		{
+			name:           "bulkRegisterValidator",
+			input:          packRegistration(t, contractAbi, true, firstKey, secondKey),
+			wantMethod:     "bulkRegisterValidator",
+			wantPublicKeys: [][]byte{firstKey, secondKey},
+		},
+		{
+			name:                "other function",
+			input:               removeValidator,
+			wantErr:             true,
+			wantNotRegistration: true,
+		},
+		{
+			name:                "unknown selector",
+			input:               []byte{0xde, 0xad, 0xbe, 0xef},
+			wantErr:             true,
+			wantNotRegistration: true,
+		},
+		{
+			name:                "short calldata",
+			input:               []byte{0x01},
+			wantErr:             true,
+			wantNotRegistration: true,
+		},
+		{
+			name:    "truncated arguments",
+			input:   registration[:len(registration)-32],
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			call, err := DecodeRegistrationCall(contractAbi, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodeRegistrationCall() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrNotRegistration) != tt.wantNotRegistration {
+				t.Fatalf("DecodeRegistrationCall() error = %v, want ErrNotRegistration %v", err, tt.wantNotRegistration)
+			}
+			if err != nil {
+				return
+			}
+
+			if call.Method != tt.wantMethod {
+				t.Errorf("Method = %q, want %q", call.Method, tt.wantMethod)
+			}
+			if len(call.PublicKeys) != len(tt.wantPublicKeys) || len(call.SharesData) != len(tt.wantPublicKeys) {
+				t.Fatalf("got %d public keys and %d shares, want %d", len(call.PublicKeys), len(call.SharesData), len(tt.wantPublicKeys))
+			}
+			for i, publicKey := range tt.wantPublicKeys {
+				if !bytes.Equal(call.PublicKeys[i], publicKey) {
+					t.Errorf("PublicKeys[%d] = %x, want %x", i, call.PublicKeys[i], publicKey)
+				}
+			}
+			if want := []uint64{1, 2, 3, 4}; !slices.Equal(call.OperatorIds, want) {
+				t.Errorf("OperatorIds = %v, want %v", call.OperatorIds, want)
+			}
+		})
+	}
+
+	// Calldata is only decoded against the contract ABI, a registration of another contract is not recognized
+	if _, err := DecodeRegistrationCall(mustParseABI(t, "[]"), registration); !errors.Is(err, ErrNotRegistration) {
+		t.Errorf("DecodeRegistrationCall() with an empty ABI error = %v, want ErrNotRegistration", err)
+	}
+}
@@ -0,0 +1,139 @@
+package noncecounter
+
+import (
+	"encoding/binary"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Cluster is the latest known snapshot of an SSV cluster, identified by its owner and operator IDs.
+type Cluster struct {
+	ID          common.Hash
+	Owner       common.Address
+	OperatorIds []uint64
+	ISSVNetworkCoreCluster
+	// UpdatedBlock is the block of the event the snapshot was taken from.
+	UpdatedBlock uint64
+	// UpdatedBy is the name of the event the snapshot was taken from.
+	UpdatedBy string
+}
+
+// ClusterTracker keeps the latest snapshot of every cluster from the cluster state carried by the contract events.
+// Its state is not persisted, so it is only complete when the scan starts at the contract deployment.
+type ClusterTracker struct {
+	mu       sync.RWMutex
+	clusters map[common.Address]map[common.Hash]*Cluster
+}
+
+// NewClusterTracker creates an empty ClusterTracker, which has to be registered on a Registry to receive events.
+func NewClusterTracker() *ClusterTracker {
+	return &ClusterTracker{
+		clusters: map[common.Address]map[common.Hash]*Cluster{},
+	}
+}
+
+// ClusterID computes the cluster ID the SSVNetwork contract uses, keccak256(abi.encodePacked(owner, operatorIds)).
+func ClusterID(owner common.Address, operatorIds []uint64) common.Hash {
+	packed := make([]byte, common.AddressLength, common.AddressLength+len(operatorIds)*common.HashLength)
+	copy(packed, owner.Bytes())
+	for _, id := range operatorIds {
+		// Array elements are padded to 32 bytes even when packed
+		var word [common.HashLength]byte
+		binary.BigEndian.PutUint64(word[common.HashLength-8:], id)
+		packed = append(packed, word[:]...)
+	}
+	return crypto.Keccak256Hash(packed)
+}
+
+// Register subscribes the tracker to every event that carries a cluster snapshot.
+func (ct *ClusterTracker) Register(r *Registry) error {
+	if err := Handle(r, "ValidatorAdded", func(e *SSVNetworkValidatorAdded, vLog types.Log) {
+		ct.update("ValidatorAdded", e.Owner, e.OperatorIds, e.Cluster, vLog)
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "ValidatorRemoved", func(e *SSVNetworkValidatorRemoved, vLog types.Log) {
+		ct.update("ValidatorRemoved", e.Owner, e.OperatorIds, e.Cluster, vLog)
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "ClusterDeposited", func(e *SSVNetworkClusterDeposited, vLog types.Log) {
+		ct.update("ClusterDeposited", e.Owner, e.OperatorIds, e.Cluster, vLog)
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "ClusterWithdrawn", func(e *SSVNetworkClusterWithdrawn, vLog types.Log) {
+		ct.update("ClusterWithdrawn", e.Owner, e.OperatorIds, e.Cluster, vLog)
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "ClusterLiquidated", func(e *SSVNetworkClusterLiquidated, vLog types.Log) {
+		ct.update("ClusterLiquidated", e.Owner, e.OperatorIds, e.Cluster, vLog)
+	}); err != nil {
+		return err
+	}
+	return Handle(r, "ClusterReactivated", func(e *SSVNetworkClusterReactivated, vLog types.Log) {
+		ct.update("ClusterReactivated", e.Owner, e.OperatorIds, e.Cluster, vLog)
+	})
+}
+
+// Clusters returns the latest snapshot of every cluster of owner, sorted by cluster ID.
+func (ct *ClusterTracker) Clusters(owner common.Address) []Cluster {
+	ct.mu.RLock()
+	defer ct.mu.RUnlock()
+
+	clusters := make([]Cluster, 0, len(ct.clusters[owner]))
+	for _, cluster := range ct.clusters[owner] {
+		clusters = append(clusters, cluster.clone())
+	}
+	slices.SortFunc(clusters, func(a, b Cluster) int {
+		return a.ID.Cmp(b.ID)
+	})
+	return clusters
+}
+
+// Cluster returns the latest snapshot of the cluster of owner made of operatorIds, if any event for it was seen.
+func (ct *ClusterTracker) Cluster(owner common.Address, operatorIds []uint64) (Cluster, bool) {
+	ct.mu.RLock()
+	defer ct.mu.RUnlock()
+
+	cluster, ok := ct.clusters[owner][ClusterID(owner, operatorIds)]
+	if !ok {
+		return Cluster{}, false
+	}
+	return cluster.clone(), true
+}
+
+// update replaces the snapshot of the cluster an event was emitted for.
+func (ct *ClusterTracker) update(eventName string, owner common.Address, operatorIds []uint64, snapshot ISSVNetworkCoreCluster, vLog types.Log) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	if _, ok := ct.clusters[owner]; !ok {
+		ct.clusters[owner] = map[common.Hash]*Cluster{}
+	}
+
+	cluster := Cluster{
+		ID:                     ClusterID(owner, operatorIds),
+		Owner:                  owner,
+		OperatorIds:            operatorIds,
+		ISSVNetworkCoreCluster: snapshot,
+		UpdatedBlock:           vLog.BlockNumber,
+		UpdatedBy:              eventName,
+	}.clone()
+	ct.clusters[owner][cluster.ID] = &cluster
+}
+
+// clone returns a deep copy of c so snapshots handed out are not affected by later updates.
+func (c Cluster) clone() Cluster {
+	c.OperatorIds = slices.Clone(c.OperatorIds)
+	if c.Balance != nil {
+		c.Balance = new(big.Int).Set(c.Balance)
+	}
+	return c
+}
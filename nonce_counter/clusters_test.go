@@ -0,0 +1,110 @@
+package noncecounter
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestClusterID(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+
+	if ClusterID(owner, []uint64{1, 2, 3, 4}) != ClusterID(owner, []uint64{1, 2, 3, 4}) {
+		t.Errorf("ClusterID() is not deterministic")
+	}
+	if ClusterID(owner, []uint64{1, 2, 3, 4}) == ClusterID(owner, []uint64{1, 2, 3, 5}) {
+		t.Errorf("ClusterID() collides for different operators")
+	}
+	if ClusterID(owner, []uint64{1, 2, 3, 4}) == ClusterID(common.Address{}, []uint64{1, 2, 3, 4}) {
+		t.Errorf("ClusterID() collides for different owners")
+	}
+}
+
+func TestClusterTracker(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	ownerTopic := []common.Hash{common.BytesToHash(owner.Bytes())}
+	operators := []uint64{1, 2, 3, 4}
+	otherOperators := []uint64{5, 6, 7, 8}
+
+	withBlock := func(vLog types.Log, block uint64) types.Log {
+		vLog.BlockNumber = block
+		return vLog
+	}
+	logs := []types.Log{
+		withBlock(newEventLog(t, contractAbi, "ValidatorAdded", ownerTopic, operators, []byte{0x01}, []byte{0x02},
+			ISSVNetworkCoreCluster{ValidatorCount: 1, Balance: big.NewInt(100), Active: true}), 10),
+		withBlock(newEventLog(t, contractAbi, "ValidatorAdded", ownerTopic, otherOperators, []byte{0x03}, []byte{0x04},
+			ISSVNetworkCoreCluster{ValidatorCount: 1, Balance: big.NewInt(50), Active: true}), 11),
+		withBlock(newEventLog(t, contractAbi, "ClusterDeposited", ownerTopic, operators, big.NewInt(20),
+			ISSVNetworkCoreCluster{ValidatorCount: 1, Balance: big.NewInt(120), Active: true}), 12),
+		withBlock(newEventLog(t, contractAbi, "ClusterLiquidated", ownerTopic, otherOperators,
+			ISSVNetworkCoreCluster{ValidatorCount: 1, Balance: big.NewInt(0), Active: false}), 13),
+	}
+
+	tracker := NewClusterTracker()
+	nc := &NonceCounter{concurrency: 2, registry: NewRegistry(contractAbi)}
+	if err := tracker.Register(nc.registry); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if _, err := nc.FindNonces(context.Background(), logs); err != nil {
+		t.Fatalf("FindNonces() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		operatorIds []uint64
+		wantBalance int64
+		wantActive  bool
+		wantBlock   uint64
+		wantEvent   string
+	}{
+		{
+			name:        "deposited cluster",
+			operatorIds: operators,
+			wantBalance: 120,
+			wantActive:  true,
+			wantBlock:   12,
+			wantEvent:   "ClusterDeposited",
+		},
+		{
+			name:        "liquidated cluster",
+			operatorIds: otherOperators,
+			wantBalance: 0,
+			wantActive:  false,
+			wantBlock:   13,
+			wantEvent:   "ClusterLiquidated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster, ok := tracker.Cluster(owner, tt.operatorIds)
+			if !ok {
+				t.Fatalf("Cluster() found no cluster")
+			}
+			if cluster.Balance.Int64() != tt.wantBalance {
+				t.Errorf("Balance = %s, want %d", cluster.Balance, tt.wantBalance)
+			}
+			if cluster.Active != tt.wantActive {
+				t.Errorf("Active = %v, want %v", cluster.Active, tt.wantActive)
+			}
+			if cluster.UpdatedBlock != tt.wantBlock {
+				t.Errorf("UpdatedBlock = %d, want %d", cluster.UpdatedBlock, tt.wantBlock)
+			}
+			if cluster.UpdatedBy != tt.wantEvent {
+				t.Errorf("UpdatedBy = %s, want %s", cluster.UpdatedBy, tt.wantEvent)
+			}
+		})
+	}
+
+	if got := len(tracker.Clusters(owner)); got != 2 {
+		t.Errorf("len(Clusters()) = %d, want 2", got)
+	}
+	if got := len(tracker.Clusters(common.Address{})); got != 0 {
+		t.Errorf("len(Clusters()) for unknown owner = %d, want 0", got)
+	}
+}
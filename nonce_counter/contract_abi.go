@@ -0,0 +1,72 @@
+package noncecounter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// LoadContractABI reads and parses the contract ABI stored at path, see ParseContractABI for the accepted formats.
+func LoadContractABI(path string) (abi.ABI, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("failed to read contract ABI: %w", err)
+	}
+	return ParseContractABI(data)
+}
+
+// ParseContractABI parses a contract ABI given either as a plain JSON array, as a compiler artifact
+// (Hardhat, Foundry, Truffle) holding it under an "abi" key, or as an Etherscan getabi response
+// holding it JSON encoded under a "result" key.
+func ParseContractABI(data []byte) (abi.ABI, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		var artifact struct {
+			ABI    json.RawMessage `json:"abi"`
+			Status string          `json:"status"`
+			Result string          `json:"result"`
+		}
+		if err := json.Unmarshal(data, &artifact); err != nil {
+			return abi.ABI{}, fmt.Errorf("failed to decode contract ABI artifact: %w", err)
+		}
+
+		switch {
+		case len(artifact.ABI) > 0:
+			data = artifact.ABI
+		case artifact.Status == "1":
+			data = []byte(artifact.Result)
+		case artifact.Status != "":
+			return abi.ABI{}, fmt.Errorf("etherscan response holds no contract ABI: %s", artifact.Result)
+		default:
+			return abi.ABI{}, fmt.Errorf("contract ABI artifact has neither an abi nor a result field")
+		}
+	}
+
+	contractAbi, err := abi.JSON(bytes.NewReader(data))
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("failed to parse contract ABI: %w", err)
+	}
+	return contractAbi, nil
+}
+
+// validateOwnerEvent checks that eventName is defined in contractAbi and identifies its owner through an indexed
+// address topic, which is what nonces are counted by.
+func validateOwnerEvent(contractAbi abi.ABI, eventName string) error {
+	event, ok := contractAbi.Events[eventName]
+	if !ok {
+		return fmt.Errorf("event %s not found in contract ABI", eventName)
+	}
+
+	for _, input := range event.Inputs {
+		if input.Name == "owner" {
+			if !input.Indexed || input.Type.T != abi.AddressTy {
+				return fmt.Errorf("event %s owner argument must be an indexed address", eventName)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("event %s has no owner argument", eventName)
+}
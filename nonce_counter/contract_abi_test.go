@@ -0,0 +1,59 @@
+package noncecounter
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseContractABI(t *testing.T) {
+	etherscanResult, err := json.Marshal(SSVNetworkMetaData.ABI)
+	if err != nil {
+		t.Fatalf("failed to encode ABI: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "plain ABI",
+			data: SSVNetworkMetaData.ABI,
+		},
+		{
+			name: "compiler artifact",
+			data: `{"contractName":"SSVNetwork","abi":` + SSVNetworkMetaData.ABI + `,"bytecode":"0x"}`,
+		},
+		{
+			name: "etherscan response",
+			data: `{"status":"1","message":"OK","result":` + string(etherscanResult) + `}`,
+		},
+		{
+			name:    "etherscan error response",
+			data:    `{"status":"0","message":"NOTOK","result":"Contract source code not verified"}`,
+			wantErr: true,
+		},
+		{
+			name:    "object without ABI",
+			data:    `{"bytecode":"0x"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			data:    `[{"type":`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contractAbi, err := ParseContractABI([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseContractABI() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if _, ok := contractAbi.Events["ValidatorAdded"]; !tt.wantErr && !ok {
+				t.Errorf("ParseContractABI() is missing the ValidatorAdded event")
+			}
+		})
+	}
+}
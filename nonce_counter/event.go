@@ -1,34 +1,30 @@
 package noncecounter
 
+//go:generate abigen --abi ../cmd/ssv_network.abi.json --pkg noncecounter --type SSVNetwork --out ssv_network_bindings.go
+
 import (
+	"errors"
 	"fmt"
-	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 )
 
-type ValidatorAddedEvent struct {
-	Owner       common.Address
-	OperatorIds []uint64
-	PublicKey   []byte
-	Shares      []byte
-	Cluster     struct {
-		ValidatorCount  uint32
-		NetworkFeeIndex uint64
-		Index           uint64
-		Active          bool
-		Balance         *big.Int
-	}
-}
+var (
+	// ErrWrongEvent is returned by Parse when the log was emitted for a different event than the one requested.
+	ErrWrongEvent = errors.New("log does not match event")
+	// ErrMalformedLog is returned by Parse when the log carries the event signature but its topics or data do not decode.
+	ErrMalformedLog = errors.New("malformed log")
+)
+
+// ValidatorAddedEvent is the ValidatorAdded event as decoded by the generated SSVNetwork bindings.
+type ValidatorAddedEvent = SSVNetworkValidatorAdded
 
+// Parse decodes vLog into vae, checking that it was emitted for eventName first.
 func (vae *ValidatorAddedEvent) Parse(eventName string, contractABI abi.ABI, vLog types.Log) error {
-	// Decode event data
-	err := contractABI.UnpackIntoInterface(vae, eventName, vLog.Data)
-	if err != nil {
-		return fmt.Errorf("failed to decode log: %v", err)
+	event, ok := contractABI.Events[eventName]
+	if !ok {
+		return fmt.Errorf("event %s not found in contract ABI", eventName)
 	}
-	vae.Owner = common.HexToAddress(vLog.Topics[1].Hex())
-	return nil
+	return decodeEvent(contractABI, event, vLog, vae)
 }
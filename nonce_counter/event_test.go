@@ -0,0 +1,147 @@
+package noncecounter
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func mustParseABI(t testing.TB, abiJSON string) abi.ABI {
+	t.Helper()
+
+	contractAbi, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("failed to parse ABI: %v", err)
+	}
+	return contractAbi
+}
+
+// newEventLog builds a log for eventName as the contract would emit it, topics holding the indexed arguments and args
+// the non indexed ones.
+func newEventLog(t testing.TB, contractAbi abi.ABI, eventName string, topics []common.Hash, args ...any) types.Log {
+	t.Helper()
+
+	event := contractAbi.Events[eventName]
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		t.Fatalf("failed to pack %s log data: %v", eventName, err)
+	}
+
+	return types.Log{
+		Topics: append([]common.Hash{event.ID}, topics...),
+		Data:   data,
+	}
+}
+
+// newValidatorAddedLog builds a ValidatorAdded log for owner as the contract would emit it.
+func newValidatorAddedLog(t testing.TB, contractAbi abi.ABI, owner common.Address) types.Log {
+	t.Helper()
+
+	cluster := ISSVNetworkCoreCluster{ValidatorCount: 1, Balance: big.NewInt(1e18)}
+	return newEventLog(t, contractAbi, "ValidatorAdded", []common.Hash{common.BytesToHash(owner.Bytes())},
+		[]uint64{1, 2, 3, 4}, []byte{0x01}, []byte{0x02}, cluster)
+}
+
+func TestValidatorAddedEventParse(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	valid := newValidatorAddedLog(t, contractAbi, owner)
+
+	tests := []struct {
+		name      string
+		vLog      types.Log
+		wantErr   bool
+		wantErrIs error
+	}{
+		{
+			name: "valid log",
+			vLog: valid,
+		},
+		{
+			name:      "different event",
+			vLog:      types.Log{Topics: []common.Hash{common.HexToHash("0x01")}, Data: valid.Data},
+			wantErr:   true,
+			wantErrIs: ErrWrongEvent,
+		},
+		{
+			name:      "no topics",
+			vLog:      types.Log{Data: valid.Data},
+			wantErr:   true,
+			wantErrIs: ErrWrongEvent,
+		},
+		{
+			name:      "missing owner topic",
+			vLog:      types.Log{Topics: valid.Topics[:1], Data: valid.Data},
+			wantErr:   true,
+			wantErrIs: ErrMalformedLog,
+		},
+		{
+			name:      "extra topics",
+			vLog:      types.Log{Topics: append(valid.Topics[:2:2], common.Hash{}), Data: valid.Data},
+			wantErr:   true,
+			wantErrIs: ErrMalformedLog,
+		},
+		{
+			name:      "owner topic is not an address",
+			vLog:      types.Log{Topics: []common.Hash{valid.Topics[0], common.HexToHash("0xff00000000000000000000000000000000000000000000000000000000000001")}, Data: valid.Data},
+			wantErr:   true,
+			wantErrIs: ErrMalformedLog,
+		},
+		{
+			name:      "malformed data",
+			vLog:      types.Log{Topics: valid.Topics, Data: []byte{0xde, 0xad}},
+			wantErr:   true,
+			wantErrIs: ErrMalformedLog,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := &ValidatorAddedEvent{}
+			err := event.Parse("ValidatorAdded", contractAbi, tt.vLog)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.wantErrIs)
+			}
+			if !tt.wantErr && event.Owner != owner {
+				t.Errorf("Owner = %s, want %s", event.Owner.Hex(), owner.Hex())
+			}
+		})
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	contractAbi := mustParseABI(f, SSVNetworkMetaData.ABI)
+	valid := newValidatorAddedLog(f, contractAbi, common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12"))
+
+	var topics []byte
+	for _, topic := range valid.Topics {
+		topics = append(topics, topic.Bytes()...)
+	}
+	f.Add(topics, valid.Data)
+	f.Add(topics[:common.HashLength], valid.Data)
+	f.Add(topics, valid.Data[:len(valid.Data)/2])
+	f.Add([]byte{}, []byte{})
+
+	f.Fuzz(func(t *testing.T, topics []byte, data []byte) {
+		// Split the raw bytes into whole topics, dropping any trailing partial hash
+		vLog := types.Log{Data: data}
+		for len(topics) >= common.HashLength {
+			vLog.Topics = append(vLog.Topics, common.BytesToHash(topics[:common.HashLength]))
+			topics = topics[common.HashLength:]
+		}
+
+		event := &ValidatorAddedEvent{}
+		err := event.Parse("ValidatorAdded", contractAbi, vLog)
+		if err != nil && !errors.Is(err, ErrWrongEvent) && !errors.Is(err, ErrMalformedLog) {
+			t.Fatalf("Parse() returned untyped error: %v", err)
+		}
+	})
+}
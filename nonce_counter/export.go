@@ -0,0 +1,157 @@
+package noncecounter
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// ExportFormat is a file format the nonce state and history can be exported to.
+type ExportFormat string
+
+// Supported export formats. Records and their fields are the same in every format, and are only ever added to.
+const (
+	// ExportJSON writes a JSON array of records.
+	ExportJSON ExportFormat = "json"
+	// ExportJSONL writes one JSON record per line, so files can be streamed and appended to.
+	ExportJSONL ExportFormat = "jsonl"
+	// ExportCSV writes a header row followed by a row per record.
+	ExportCSV ExportFormat = "csv"
+)
+
+// ParseExportFormat returns the export format with the given name.
+func ParseExportFormat(name string) (ExportFormat, error) {
+	switch format := ExportFormat(name); format {
+	case ExportJSON, ExportJSONL, ExportCSV:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown export format %q, expected json, jsonl or csv", name)
+	}
+}
+
+// NonceRecord is the exported nonce of a tracked owner.
+type NonceRecord struct {
+	Owner string `json:"owner"`
+	// NextNonce is the nonce the next registration of the owner has to be signed with.
+	NextNonce uint64 `json:"nextNonce"`
+	// Block is the last block processed when the nonce was exported, 0 when no block was.
+	Block uint64 `json:"block"`
+}
+
+// IncrementRecord is an exported nonce increment.
+type IncrementRecord struct {
+	Owner       string `json:"owner"`
+	Nonce       uint64 `json:"nonce"`
+	BlockNumber uint64 `json:"blockNumber"`
+	BlockHash   string `json:"blockHash"`
+	TxHash      string `json:"txHash"`
+	LogIndex    uint   `json:"logIndex"`
+	// Timestamp is the RFC 3339 block time, null when block timestamps are not fetched.
+	Timestamp *string `json:"timestamp"`
+}
+
+// nonceRecordHeader and incrementRecordHeader are the CSV columns of the records, named after their JSON fields.
+var (
+	nonceRecordHeader     = []string{"owner", "nextNonce", "block"}
+	incrementRecordHeader = []string{"owner", "nonce", "blockNumber", "blockHash", "txHash", "logIndex", "timestamp"}
+)
+
+// NonceRecords returns the nonces of every tracked owner, sorted by owner address.
+func (nc *NonceCounter) NonceRecords() []NonceRecord {
+	block, _ := nc.LastBlock()
+
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	records := make([]NonceRecord, 0, len(nc.addressToNonce))
+	for address, nonce := range nc.addressToNonce {
+		records = append(records, NonceRecord{Owner: address, NextNonce: nonce, Block: block})
+	}
+	slices.SortFunc(records, func(a, b NonceRecord) int {
+		return cmp.Compare(a.Owner, b.Owner)
+	})
+	return records
+}
+
+// IncrementRecords returns the nonce increments of every tracked owner, in chain order.
+func (nc *NonceCounter) IncrementRecords() []IncrementRecord {
+	history := nc.History()
+	records := make([]IncrementRecord, 0, len(history))
+	for _, increment := range history {
+		records = append(records, newIncrementRecord(increment))
+	}
+	return records
+}
+
+// newIncrementRecord returns the record of increment.
+func newIncrementRecord(increment Increment) IncrementRecord {
+	record := IncrementRecord{
+		Owner:       increment.Owner.Hex(),
+		Nonce:       increment.Nonce,
+		BlockNumber: increment.BlockNumber,
+		BlockHash:   increment.BlockHash.Hex(),
+		TxHash:      increment.TxHash.Hex(),
+		LogIndex:    increment.LogIndex,
+	}
+	if !increment.Timestamp.IsZero() {
+		timestamp := increment.Timestamp.UTC().Format(time.RFC3339)
+		record.Timestamp = &timestamp
+	}
+	return record
+}
+
+// ExportNonces writes the nonces of every tracked owner to w in the given format.
+func (nc *NonceCounter) ExportNonces(w io.Writer, format ExportFormat) error {
+	return writeRecords(w, format, nonceRecordHeader, nc.NonceRecords(), func(r NonceRecord) []string {
+		return []string{r.Owner, strconv.FormatUint(r.NextNonce, 10), strconv.FormatUint(r.Block, 10)}
+	})
+}
+
+// ExportHistory writes the nonce increments of every tracked owner to w in the given format.
+func (nc *NonceCounter) ExportHistory(w io.Writer, format ExportFormat) error {
+	return writeRecords(w, format, incrementRecordHeader, nc.IncrementRecords(), func(r IncrementRecord) []string {
+		var timestamp string
+		if r.Timestamp != nil {
+			timestamp = *r.Timestamp
+		}
+		return []string{r.Owner, strconv.FormatUint(r.Nonce, 10), strconv.FormatUint(r.BlockNumber, 10), r.BlockHash,
+			r.TxHash, strconv.FormatUint(uint64(r.LogIndex), 10), timestamp}
+	})
+}
+
+// writeRecords writes records to w in the given format, using header and row for CSV.
+func writeRecords[T any](w io.Writer, format ExportFormat, header []string, records []T, row func(T) []string) error {
+	switch format {
+	case ExportJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	case ExportJSONL:
+		encoder := json.NewEncoder(w)
+		for _, record := range records {
+			if err := encoder.Encode(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	case ExportCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+		for _, record := range records {
+			if err := writer.Write(row(record)); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	default:
+		return fmt.Errorf("unknown export format %q", format)
+	}
+}
@@ -0,0 +1,130 @@
+package noncecounter
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestParseExportFormat(t *testing.T) {
+	for _, name := range []string{"json", "jsonl", "csv"} {
+		if format, err := ParseExportFormat(name); err != nil || string(format) != name {
+			t.Errorf("ParseExportFormat(%q) = %q, %v", name, format, err)
+		}
+	}
+	if _, err := ParseExportFormat("xml"); err == nil {
+		t.Errorf("ParseExportFormat() of an unknown format succeeded")
+	}
+}
+
+func TestExport(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	otherOwner := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+	blockHash := common.HexToHash("0xbb")
+	txHash := common.HexToHash("0xaa")
+
+	nc := &NonceCounter{
+		addressToNonce: map[string]uint64{owner.Hex(): 2, otherOwner.Hex(): 0},
+		history: []Increment{
+			{Owner: owner, Nonce: 0, BlockNumber: 10, BlockHash: blockHash, TxHash: txHash, LogIndex: 3,
+				Timestamp: time.Unix(1700000000, 0)},
+			{Owner: owner, Nonce: 1, BlockNumber: 10, BlockHash: blockHash, TxHash: txHash, LogIndex: 4},
+		},
+	}
+	nc.lastBlock.Store(42)
+	nc.processed.Store(true)
+
+	tests := []struct {
+		name        string
+		format      ExportFormat
+		wantNonces  string
+		wantHistory string
+	}{
+		{
+			name:   "json",
+			format: ExportJSON,
+			wantNonces: `[
+  {
+    "owner": "0x1234567890AbcdEF1234567890aBcdef12345678",
+    "nextNonce": 0,
+    "block": 42
+  },
+  {
+    "owner": "0xabCDEF1234567890ABcDEF1234567890aBCDeF12",
+    "nextNonce": 2,
+    "block": 42
+  }
+]
+`,
+			wantHistory: `[
+  {
+    "owner": "0xabCDEF1234567890ABcDEF1234567890aBCDeF12",
+    "nonce": 0,
+    "blockNumber": 10,
+    "blockHash": "0x00000000000000000000000000000000000000000000000000000000000000bb",
+    "txHash": "0x00000000000000000000000000000000000000000000000000000000000000aa",
+    "logIndex": 3,
+    "timestamp": "2023-11-14T22:13:20Z"
+  },
+  {
+    "owner": "0xabCDEF1234567890ABcDEF1234567890aBCDeF12",
+    "nonce": 1,
+    "blockNumber": 10,
+    "blockHash": "0x00000000000000000000000000000000000000000000000000000000000000bb",
+    "txHash": "0x00000000000000000000000000000000000000000000000000000000000000aa",
+    "logIndex": 4,
+    "timestamp": null
+  }
+]
+`,
+		},
+		{
+			name:   "jsonl",
+			format: ExportJSONL,
+			wantNonces: `{"owner":"0x1234567890AbcdEF1234567890aBcdef12345678","nextNonce":0,"block":42}
+{"owner":"0xabCDEF1234567890ABcDEF1234567890aBCDeF12","nextNonce":2,"block":42}
+`,
+			wantHistory: `{"owner":"0xabCDEF1234567890ABcDEF1234567890aBCDeF12","nonce":0,"blockNumber":10,"blockHash":"0x00000000000000000000000000000000000000000000000000000000000000bb","txHash":"0x00000000000000000000000000000000000000000000000000000000000000aa","logIndex":3,"timestamp":"2023-11-14T22:13:20Z"}
+{"owner":"0xabCDEF1234567890ABcDEF1234567890aBCDeF12","nonce":1,"blockNumber":10,"blockHash":"0x00000000000000000000000000000000000000000000000000000000000000bb","txHash":"0x00000000000000000000000000000000000000000000000000000000000000aa","logIndex":4,"timestamp":null}
+`,
+		},
+		{
+			name:   "csv",
+			format: ExportCSV,
+			wantNonces: `owner,nextNonce,block
+0x1234567890AbcdEF1234567890aBcdef12345678,0,42
+0xabCDEF1234567890ABcDEF1234567890aBCDeF12,2,42
+`,
+			wantHistory: `owner,nonce,blockNumber,blockHash,txHash,logIndex,timestamp
+0xabCDEF1234567890ABcDEF1234567890aBCDeF12,0,10,0x00000000000000000000000000000000000000000000000000000000000000bb,0x00000000000000000000000000000000000000000000000000000000000000aa,3,2023-11-14T22:13:20Z
+0xabCDEF1234567890ABcDEF1234567890aBCDeF12,1,10,0x00000000000000000000000000000000000000000000000000000000000000bb,0x00000000000000000000000000000000000000000000000000000000000000aa,4,
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nonces, history bytes.Buffer
+			if err := nc.ExportNonces(&nonces, tt.format); err != nil {
+				t.Fatalf("ExportNonces() error = %v", err)
+			}
+			if err := nc.ExportHistory(&history, tt.format); err != nil {
+				t.Fatalf("ExportHistory() error = %v", err)
+			}
+			if got := nonces.String(); got != tt.wantNonces {
+				t.Errorf("ExportNonces() =\n%s\nwant\n%s", got, tt.wantNonces)
+			}
+			if got := history.String(); got != tt.wantHistory {
+				t.Errorf("ExportHistory() =\n%s\nwant\n%s", got, tt.wantHistory)
+			}
+		})
+	}
+
+	// An empty history is still a valid document
+	var empty bytes.Buffer
+	if err := (&NonceCounter{}).ExportHistory(&empty, ExportJSON); err != nil || empty.String() != "[]\n" {
+		t.Errorf("ExportHistory() of an empty history = %q, %v, want %q", empty.String(), err, "[]\n")
+	}
+}
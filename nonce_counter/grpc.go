@@ -0,0 +1,159 @@
+package noncecounter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rem1niscence/ssv-nounce-counter/nonce_counter/noncecounterpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchBuffer is the number of nonce increments a WatchNonces stream may fall behind before it is dropped.
+const watchBuffer = 1024
+
+// GRPCServer serves the nonces and scan status of a NonceCounter over the NonceCounter gRPC service defined in
+// proto/noncecounter/v1/nonce_counter.proto.
+type GRPCServer struct {
+	noncecounterpb.UnimplementedNonceCounterServer
+	nc *NonceCounter
+}
+
+// NewGRPCServer returns a gRPC service backed by nc.
+func NewGRPCServer(nc *NonceCounter) *GRPCServer {
+	return &GRPCServer{nc: nc}
+}
+
+// Register registers the service on server.
+func (s *GRPCServer) Register(server *grpc.Server) {
+	noncecounterpb.RegisterNonceCounterServer(server, s)
+}
+
+// GetNonce returns the next nonce of a tracked owner.
+func (s *GRPCServer) GetNonce(_ context.Context, req *noncecounterpb.GetNonceRequest) (*noncecounterpb.GetNonceResponse, error) {
+	owner, err := parseOwner(req.GetOwner())
+	if err != nil {
+		return nil, err
+	}
+	nonce, ok := s.nc.NextNonce(owner)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "owner %s is not tracked", owner.Hex())
+	}
+	return &noncecounterpb.GetNonceResponse{Nonce: s.nonce(owner, nonce)}, nil
+}
+
+// ListNonces returns the next nonce of every tracked owner, ordered by owner address.
+func (s *GRPCServer) ListNonces(context.Context, *noncecounterpb.ListNoncesRequest) (*noncecounterpb.ListNoncesResponse, error) {
+	nonces := s.nc.Nonces()
+	resp := &noncecounterpb.ListNoncesResponse{Nonces: make([]*noncecounterpb.Nonce, 0, len(nonces))}
+	for _, owner := range sortedOwners(nonces) {
+		resp.Nonces = append(resp.Nonces, s.nonce(owner, nonces[owner]))
+	}
+	return resp, nil
+}
+
+// GetStatus returns the scan progress of the counter.
+func (s *GRPCServer) GetStatus(context.Context, *noncecounterpb.GetStatusRequest) (*noncecounterpb.GetStatusResponse, error) {
+	block, processed := s.nc.LastBlock()
+	return &noncecounterpb.GetStatusResponse{
+		ContractAddress: common.HexToAddress(s.nc.contractAddress).Hex(),
+		LastBlock:       block,
+		Processed:       processed,
+		Synced:          s.nc.Synced(),
+		TrackedOwners:   uint32(len(s.nc.addresses)),
+		DecodeErrors:    s.nc.DecodeErrors(),
+	}, nil
+}
+
+// WatchNonces streams the current nonce of the requested owners, then every nonce they consume.
+func (s *GRPCServer) WatchNonces(req *noncecounterpb.WatchNoncesRequest, stream noncecounterpb.NonceCounter_WatchNoncesServer) error {
+	filter := map[common.Address]bool{}
+	for _, address := range req.GetOwners() {
+		owner, err := parseOwner(address)
+		if err != nil {
+			return err
+		}
+		if !s.nc.isTracked(owner) {
+			return status.Errorf(codes.NotFound, "owner %s is not tracked", owner.Hex())
+		}
+		filter[owner] = true
+	}
+	watched := func(owner common.Address) bool {
+		return len(filter) == 0 || filter[owner]
+	}
+
+	nonces, increments, stop := s.nc.Watch(watchBuffer)
+	defer stop()
+
+	block, _ := s.nc.LastBlock()
+	for _, owner := range sortedOwners(nonces) {
+		if !watched(owner) {
+			continue
+		}
+		if err := stream.Send(&noncecounterpb.NonceUpdate{Owner: owner.Hex(), NextNonce: nonces[owner], BlockNumber: block}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case increment, ok := <-increments:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "client fell too far behind the nonce updates")
+			}
+			if !watched(increment.Owner) {
+				continue
+			}
+			update := &noncecounterpb.NonceUpdate{
+				Owner:       increment.Owner.Hex(),
+				NextNonce:   increment.Nonce + 1,
+				BlockNumber: increment.BlockNumber,
+				TxHash:      increment.TxHash.Hex(),
+				LogIndex:    uint32(increment.LogIndex),
+			}
+			if !increment.Timestamp.IsZero() {
+				update.Timestamp = increment.Timestamp.UTC().Format(time.RFC3339)
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// nonce returns the Nonce message of owner.
+func (s *GRPCServer) nonce(owner common.Address, next uint64) *noncecounterpb.Nonce {
+	block, _ := s.nc.LastBlock()
+	msg := &noncecounterpb.Nonce{Owner: owner.Hex(), NextNonce: next, BlockNumber: block}
+	if s.nc.pendingNonces {
+		pending, _ := s.nc.PendingNonce(owner)
+		msg.PendingNextNonce = &pending
+	}
+	return msg
+}
+
+// parseOwner parses a hex owner address, failing with INVALID_ARGUMENT.
+func parseOwner(address string) (common.Address, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid owner address %q", address))
+	}
+	return common.HexToAddress(address), nil
+}
+
+// sortedOwners returns the owners of nonces ordered by address.
+func sortedOwners(nonces map[common.Address]uint64) []common.Address {
+	owners := make([]common.Address, 0, len(nonces))
+	for owner := range nonces {
+		owners = append(owners, owner)
+	}
+	slices.SortFunc(owners, func(a, b common.Address) int {
+		return a.Cmp(b)
+	})
+	return owners
+}
@@ -0,0 +1,146 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rem1niscence/ssv-nounce-counter/nonce_counter/noncecounterpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newGRPCClient serves nc on an in-process listener and returns a client of it.
+func newGRPCClient(t *testing.T, nc *NonceCounter) noncecounterpb.NonceCounterClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	NewGRPCServer(nc).Register(server)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to dial gRPC server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return noncecounterpb.NewNonceCounterClient(conn)
+}
+
+func TestGRPCQueries(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	otherOwner := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+	config := newTestConfig(&bytes.Buffer{}, owner, otherOwner)
+	config.ContractAddress = "0x38A4794cCEd47d3baf7370CcC43B560D3a1beEFA"
+	nc := newTestNonceCounter(t, config)
+	client := newGRPCClient(t, nc)
+	ctx := context.Background()
+
+	vLog := newValidatorAddedLog(t, contractAbi, owner)
+	vLog.BlockNumber = 10
+	if err := nc.processBatch(ctx, Batch{FromBlock: 1, ToBlock: 20, Logs: 1}, []types.Log{vLog}, nil); err != nil {
+		t.Fatalf("processBatch() error = %v", err)
+	}
+
+	resp, err := client.GetNonce(ctx, &noncecounterpb.GetNonceRequest{Owner: owner.Hex()})
+	if err != nil || resp.GetNonce().GetNextNonce() != 1 || resp.GetNonce().GetBlockNumber() != 20 ||
+		resp.GetNonce().PendingNextNonce != nil {
+		t.Errorf("GetNonce() = %v, %v, want next nonce 1 at block 20", resp, err)
+	}
+
+	for _, tt := range []struct {
+		owner string
+		want  codes.Code
+	}{{"0x01", codes.InvalidArgument}, {common.HexToAddress("0x02").Hex(), codes.NotFound}} {
+		if _, err := client.GetNonce(ctx, &noncecounterpb.GetNonceRequest{Owner: tt.owner}); status.Code(err) != tt.want {
+			t.Errorf("GetNonce(%s) error = %v, want code %v", tt.owner, err, tt.want)
+		}
+	}
+
+	list, err := client.ListNonces(ctx, &noncecounterpb.ListNoncesRequest{})
+	if err != nil || len(list.GetNonces()) != 2 || list.GetNonces()[0].GetOwner() != otherOwner.Hex() ||
+		list.GetNonces()[1].GetNextNonce() != 1 {
+		t.Errorf("ListNonces() = %v, %v", list, err)
+	}
+
+	statusResp, err := client.GetStatus(ctx, &noncecounterpb.GetStatusRequest{})
+	if err != nil || statusResp.GetLastBlock() != 20 || !statusResp.GetProcessed() || statusResp.GetSynced() ||
+		statusResp.GetTrackedOwners() != 2 || statusResp.GetContractAddress() != nc.contractAddress {
+		t.Errorf("GetStatus() = %v, %v", statusResp, err)
+	}
+}
+
+func TestGRPCWatchNonces(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	otherOwner := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+	nc := newReplayNonceCounter(t, &bytes.Buffer{}, owner, otherOwner)
+	client := newGRPCClient(t, nc)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.WatchNonces(ctx, &noncecounterpb.WatchNoncesRequest{Owners: []string{owner.Hex()}})
+	if err != nil {
+		t.Fatalf("WatchNonces() error = %v", err)
+	}
+	current, err := stream.Recv()
+	if err != nil || current.GetOwner() != owner.Hex() || current.GetNextNonce() != 0 || current.GetTxHash() != "" {
+		t.Fatalf("first update = %v, %v, want the current nonce of %s", current, err, owner.Hex())
+	}
+
+	var logs []types.Log
+	for i, spec := range []struct {
+		owner common.Address
+		block uint64
+	}{{otherOwner, 10}, {owner, 10}, {owner, 11}} {
+		vLog := newValidatorAddedLog(t, contractAbi, spec.owner)
+		vLog.BlockNumber = spec.block
+		vLog.TxHash = common.BigToHash(common.Big3)
+		vLog.Index = uint(i)
+		logs = append(logs, vLog)
+	}
+	if err := nc.processBatch(ctx, Batch{FromBlock: 1, ToBlock: 20, Logs: len(logs)}, logs, nil); err != nil {
+		t.Fatalf("processBatch() error = %v", err)
+	}
+
+	for _, want := range []struct {
+		nonce    uint64
+		block    uint64
+		logIndex uint32
+	}{{1, 10, 1}, {2, 11, 2}} {
+		update, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if update.GetOwner() != owner.Hex() || update.GetNextNonce() != want.nonce || update.GetBlockNumber() != want.block ||
+			update.GetLogIndex() != want.logIndex || update.GetTxHash() != logs[0].TxHash.Hex() {
+			t.Errorf("update = %v, want nonce %d at block %d log %d", update, want.nonce, want.block, want.logIndex)
+		}
+	}
+
+	// The error of a server stream surfaces on its first Recv
+	for _, tt := range []struct {
+		owner string
+		want  codes.Code
+	}{{"0x01", codes.InvalidArgument}, {common.HexToAddress("0x02").Hex(), codes.NotFound}} {
+		invalid, err := client.WatchNonces(ctx, &noncecounterpb.WatchNoncesRequest{Owners: []string{tt.owner}})
+		if err != nil {
+			t.Fatalf("WatchNonces() error = %v", err)
+		}
+		if _, err := invalid.Recv(); status.Code(err) != tt.want {
+			t.Errorf("Recv() of the stream of %s error = %v, want code %v", tt.owner, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package noncecounter
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Increment is a nonce consumed by a ValidatorAdded event of a tracked owner.
+type Increment struct {
+	Owner common.Address
+	// Nonce is the nonce the registration consumed, the owner's next nonce is one more.
+	Nonce       uint64
+	BlockNumber uint64
+	BlockHash   common.Hash
+	TxHash      common.Hash
+	LogIndex    uint
+	// Timestamp is the time of the block, zero unless BlockTimestamps is enabled and the header was fetched.
+	Timestamp time.Time
+}
+
+// String returns a one line description of the increment.
+func (i Increment) String() string {
+	s := fmt.Sprintf("Address: %s, Nonce: %d, Block: %d", i.Owner.Hex(), i.Nonce, i.BlockNumber)
+	if !i.Timestamp.IsZero() {
+		s += ", Time: " + i.Timestamp.UTC().Format(time.RFC3339)
+	}
+	return s
+}
+
+// Increments returns every nonce increment of owner recorded since the counter was created, in chain order.
+func (nc *NonceCounter) Increments(owner common.Address) []Increment {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	var increments []Increment
+	for _, increment := range nc.history {
+		if increment.Owner == owner {
+			increments = append(increments, increment)
+		}
+	}
+	return increments
+}
+
+// History returns every nonce increment of the tracked owners recorded since the counter was created, in chain order.
+func (nc *NonceCounter) History() []Increment {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	return slices.Clone(nc.history)
+}
+
+// recordIncrement appends the nonce consumed by a ValidatorAdded log of a tracked owner to the history.
+func (nc *NonceCounter) recordIncrement(owner common.Address, nonce uint64, vLog types.Log) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	nc.history = append(nc.history, Increment{
+		Owner:       owner,
+		Nonce:       nonce,
+		BlockNumber: vLog.BlockNumber,
+		BlockHash:   vLog.BlockHash,
+		TxHash:      vLog.TxHash,
+		LogIndex:    vLog.Index,
+	})
+}
+
+// historySince returns a copy of the increments recorded from index from on.
+func (nc *NonceCounter) historySince(from int) []Increment {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	return slices.Clone(nc.history[from:])
+}
+
+// incrementOf returns the increment recorded for vLog, and false if it did not increment a tracked nonce. Registry
+// handlers dispatched after the counter's own one find it last in the history.
+func (nc *NonceCounter) incrementOf(vLog types.Log) (Increment, bool) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	if len(nc.history) == 0 {
+		return Increment{}, false
+	}
+	last := nc.history[len(nc.history)-1]
+	if last.TxHash != vLog.TxHash || last.LogIndex != vLog.Index || last.BlockNumber != vLog.BlockNumber {
+		return Increment{}, false
+	}
+	return last, true
+}
+
+// historyLen returns the number of recorded increments.
+func (nc *NonceCounter) historyLen() int {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	return len(nc.history)
+}
+
+// printIncrements prints the increments recorded from index from on.
+func (nc *NonceCounter) printIncrements(from int) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	for _, increment := range nc.history[from:] {
+		fmt.Fprintln(nc.out(), increment)
+	}
+}
@@ -0,0 +1,68 @@
+package noncecounter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestIncrementHistory(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	otherOwner := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+	untracked := common.HexToAddress("0x9a8e8762CE71B669250e964d5262C390416aB3BA")
+
+	var logs []types.Log
+	for i, logOwner := range []common.Address{owner, untracked, otherOwner, owner} {
+		vLog := newValidatorAddedLog(t, contractAbi, logOwner)
+		vLog.BlockNumber = uint64(100 + i)
+		vLog.TxHash = common.BigToHash(common.Big1)
+		vLog.Index = uint(i)
+		logs = append(logs, vLog)
+	}
+
+	nc := &NonceCounter{
+		eventName:      "ValidatorAdded",
+		contractAbi:    contractAbi,
+		addresses:      []string{owner.Hex(), otherOwner.Hex()},
+		addressToNonce: map[string]uint64{owner.Hex(): 4, otherOwner.Hex(): 0},
+		concurrency:    2,
+		registry:       NewRegistry(contractAbi),
+	}
+	if err := Handle(nc.registry, nc.eventName, nc.handleValidatorAdded); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if _, err := nc.FindNonces(context.Background(), logs); err != nil {
+		t.Fatalf("FindNonces() error = %v", err)
+	}
+
+	want := []Increment{
+		{Owner: owner, Nonce: 4, BlockNumber: 100, TxHash: common.BigToHash(common.Big1), LogIndex: 0},
+		{Owner: otherOwner, Nonce: 0, BlockNumber: 102, TxHash: common.BigToHash(common.Big1), LogIndex: 2},
+		{Owner: owner, Nonce: 5, BlockNumber: 103, TxHash: common.BigToHash(common.Big1), LogIndex: 3},
+	}
+	history := nc.History()
+	if len(history) != len(want) {
+		t.Fatalf("History() = %v, want %v", history, want)
+	}
+	for i := range want {
+		if history[i] != want[i] {
+			t.Errorf("History()[%d] = %+v, want %+v", i, history[i], want[i])
+		}
+	}
+	if got := nc.Increments(owner); len(got) != 2 || got[0].Nonce != 4 || got[1].Nonce != 5 {
+		t.Errorf("Increments() = %v, want nonces 4 and 5", got)
+	}
+
+	increment := Increment{Owner: owner, Nonce: 4, BlockNumber: 100}
+	if got, want := increment.String(), "Address: "+owner.Hex()+", Nonce: 4, Block: 100"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	increment.Timestamp = time.Unix(1700000000, 0)
+	if got, want := increment.String(), "Address: "+owner.Hex()+", Nonce: 4, Block: 100, Time: 2023-11-14T22:13:20Z"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
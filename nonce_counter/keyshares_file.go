@@ -0,0 +1,148 @@
+package noncecounter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// KeySharesFile is a keyshares.json file as generated by ssv-keys, holding the shares of one or more validators in
+// the order they will be registered.
+type KeySharesFile struct {
+	Version   string           `json:"version"`
+	CreatedAt string           `json:"createdAt"`
+	Shares    []KeySharesEntry `json:"shares"`
+}
+
+// KeySharesEntry holds the shares of a single validator.
+type KeySharesEntry struct {
+	Data struct {
+		OwnerNonce   uint64         `json:"ownerNonce"`
+		OwnerAddress common.Address `json:"ownerAddress"`
+		PublicKey    hexutil.Bytes  `json:"publicKey"`
+	} `json:"data"`
+	Payload struct {
+		PublicKey   hexutil.Bytes `json:"publicKey"`
+		OperatorIds []uint64      `json:"operatorIds"`
+		SharesData  hexutil.Bytes `json:"sharesData"`
+	} `json:"payload"`
+}
+
+// KeySharesIssueKind classifies the problems VerifyKeyShares reports.
+type KeySharesIssueKind string
+
+// Problems that would make a registration fail or consume an unexpected nonce.
+const (
+	// KeySharesUntracked is reported for owners the counter does not track, whose nonce is unknown.
+	KeySharesUntracked KeySharesIssueKind = "untracked"
+	// KeySharesStale is reported for nonces the owner already used on chain.
+	KeySharesStale KeySharesIssueKind = "stale"
+	// KeySharesDuplicated is reported for nonces used by an earlier entry of the same owner in the file.
+	KeySharesDuplicated KeySharesIssueKind = "duplicated"
+	// KeySharesOutOfOrder is reported for nonces that are not the next one once the earlier entries are registered.
+	KeySharesOutOfOrder KeySharesIssueKind = "out-of-order"
+	// KeySharesBadSignature is reported for shares whose signature does not sign the owner and the declared nonce.
+	KeySharesBadSignature KeySharesIssueKind = "bad-signature"
+)
+
+// KeySharesIssue is a problem found in a keyshares file entry.
+type KeySharesIssue struct {
+	// Index of the entry in the file.
+	Index         int
+	Kind          KeySharesIssueKind
+	Owner         common.Address
+	PublicKey     []byte
+	Nonce         uint64
+	ExpectedNonce uint64
+	Detail        string
+}
+
+// String returns a one line description of the issue.
+func (ksi KeySharesIssue) String() string {
+	s := fmt.Sprintf("share %d (validator %s, owner %s): %s nonce %d, expected %d", ksi.Index,
+		hexutil.Encode(ksi.PublicKey), ksi.Owner.Hex(), ksi.Kind, ksi.Nonce, ksi.ExpectedNonce)
+	if ksi.Detail != "" {
+		s += ": " + ksi.Detail
+	}
+	return s
+}
+
+// LoadKeySharesFile reads and decodes the keyshares file at path.
+func LoadKeySharesFile(path string) (KeySharesFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return KeySharesFile{}, fmt.Errorf("failed to read keyshares file: %w", err)
+	}
+
+	var file KeySharesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return KeySharesFile{}, fmt.Errorf("failed to decode keyshares file: %w", err)
+	}
+	if len(file.Shares) == 0 {
+		return KeySharesFile{}, fmt.Errorf("keyshares file holds no shares")
+	}
+	return file, nil
+}
+
+// VerifyKeyShares checks the nonces of a keyshares file against the counted ones, as if its entries were registered
+// in file order. Each entry must use the next nonce of its owner, and its shares signature must sign that nonce.
+// It returns the issues found, none meaning the file can be submitted as is.
+func (nc *NonceCounter) VerifyKeyShares(file KeySharesFile) []KeySharesIssue {
+	var issues []KeySharesIssue
+	expected := map[common.Address]uint64{}
+	used := map[common.Address][]uint64{}
+
+	for i, entry := range file.Shares {
+		owner := entry.Data.OwnerAddress
+		nonce := entry.Data.OwnerNonce
+		publicKey := entry.Payload.PublicKey
+		if len(publicKey) == 0 {
+			publicKey = entry.Data.PublicKey
+		}
+		issue := KeySharesIssue{Index: i, Owner: owner, PublicKey: publicKey, Nonce: nonce}
+
+		next, ok := expected[owner]
+		if !ok {
+			onChain, tracked := nc.NextNonce(owner)
+			if !tracked {
+				issue.Kind = KeySharesUntracked
+				issues = append(issues, issue)
+				continue
+			}
+			next = onChain
+		}
+		issue.ExpectedNonce = next
+
+		onChain, _ := nc.NextNonce(owner)
+		switch {
+		case nonce < onChain:
+			issue.Kind = KeySharesStale
+		case slices.Contains(used[owner], nonce):
+			issue.Kind = KeySharesDuplicated
+		case nonce != next:
+			issue.Kind = KeySharesOutOfOrder
+		}
+		if issue.Kind != "" {
+			issues = append(issues, issue)
+		}
+
+		// The contract assigns nonces in registration order regardless of the ones the shares were signed with
+		expected[owner] = next + 1
+		used[owner] = append(used[owner], nonce)
+
+		keyShares, err := ParseShares(entry.Payload.SharesData, len(entry.Payload.OperatorIds))
+		if err == nil {
+			err = VerifyShareSignature(keyShares.Signature, publicKey, owner, nonce)
+		}
+		if err != nil {
+			issue.Kind = KeySharesBadSignature
+			issue.Detail = err.Error()
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
@@ -0,0 +1,104 @@
+package noncecounter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// newKeySharesEntry builds a keyshares file entry declaring nonce, with shares signed over signedNonce.
+func newKeySharesEntry(t testing.TB, secretKey int64, owner common.Address, nonce, signedNonce uint64) KeySharesEntry {
+	t.Helper()
+
+	publicKey, shares := signShares(t, secretKey, owner, signedNonce, 4)
+	var entry KeySharesEntry
+	entry.Data.OwnerAddress = owner
+	entry.Data.OwnerNonce = nonce
+	entry.Data.PublicKey = publicKey
+	entry.Payload.PublicKey = publicKey
+	entry.Payload.OperatorIds = []uint64{1, 2, 3, 4}
+	entry.Payload.SharesData = shares
+	return entry
+}
+
+func TestLoadKeySharesFile(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	want := KeySharesFile{
+		Version:   "v1.1.0",
+		CreatedAt: "2024-01-01T00:00:00.000Z",
+		Shares:    []KeySharesEntry{newKeySharesEntry(t, 1, owner, 5, 5)},
+	}
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("failed to encode keyshares file: %v", err)
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "keyshares.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write keyshares file: %v", err)
+	}
+	emptyPath := filepath.Join(dir, "empty.json")
+	if err := os.WriteFile(emptyPath, []byte(`{"version":"v1.1.0","shares":[]}`), 0o600); err != nil {
+		t.Fatalf("failed to write keyshares file: %v", err)
+	}
+
+	got, err := LoadKeySharesFile(path)
+	if err != nil {
+		t.Fatalf("LoadKeySharesFile() error = %v", err)
+	}
+	if len(got.Shares) != 1 || got.Shares[0].Data.OwnerAddress != owner || got.Shares[0].Data.OwnerNonce != 5 {
+		t.Errorf("LoadKeySharesFile() = %+v, want %+v", got, want)
+	}
+
+	if _, err := LoadKeySharesFile(emptyPath); err == nil {
+		t.Errorf("LoadKeySharesFile() of a file without shares succeeded")
+	}
+	if _, err := LoadKeySharesFile(filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("LoadKeySharesFile() of a missing file succeeded")
+	}
+}
+
+func TestVerifyKeyShares(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	otherOwner := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+	untracked := common.HexToAddress("0x9a8e8762CE71B669250e964d5262C390416aB3BA")
+
+	nc := &NonceCounter{
+		addressToNonce: map[string]uint64{owner.Hex(): 2, otherOwner.Hex(): 0},
+	}
+	file := KeySharesFile{Shares: []KeySharesEntry{
+		newKeySharesEntry(t, 1, owner, 2, 2),
+		newKeySharesEntry(t, 2, owner, 3, 3),
+		newKeySharesEntry(t, 3, owner, 3, 3),
+		newKeySharesEntry(t, 4, owner, 1, 1),
+		newKeySharesEntry(t, 5, owner, 7, 7),
+		newKeySharesEntry(t, 6, untracked, 0, 0),
+		newKeySharesEntry(t, 7, otherOwner, 0, 5),
+	}}
+
+	want := []struct {
+		index         int
+		kind          KeySharesIssueKind
+		expectedNonce uint64
+	}{
+		{index: 2, kind: KeySharesDuplicated, expectedNonce: 4},
+		{index: 3, kind: KeySharesStale, expectedNonce: 5},
+		{index: 4, kind: KeySharesOutOfOrder, expectedNonce: 6},
+		{index: 5, kind: KeySharesUntracked},
+		{index: 6, kind: KeySharesBadSignature},
+	}
+
+	issues := nc.VerifyKeyShares(file)
+	if len(issues) != len(want) {
+		t.Fatalf("VerifyKeyShares() = %v, want %d issues", issues, len(want))
+	}
+	for i, w := range want {
+		if issues[i].Index != w.index || issues[i].Kind != w.kind || issues[i].ExpectedNonce != w.expectedNonce {
+			t.Errorf("issue %d = %s, want %s at index %d expecting nonce %d", i, issues[i], w.kind, w.index, w.expectedNonce)
+		}
+	}
+}
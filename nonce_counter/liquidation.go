@@ -0,0 +1,249 @@
+package noncecounter
+
+import (
+	"log/slog"
+	"math"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// deductedDigits is the factor by which the contract shrinks fees to pack its indexes in uint64.
+const deductedDigits = 10_000_000
+
+// LiquidationConfig represents the configuration of a LiquidationMonitor.
+type LiquidationConfig struct {
+	// Owners whose clusters are monitored.
+	Owners []common.Address
+	// WarnRunwayBlocks is the runway below which a cluster is reported.
+	WarnRunwayBlocks uint64
+	// NetworkFee, LiquidationThresholdPeriod and MinimumLiquidationCollateral are the contract parameters in effect
+	// before the first scanned block. Parameter update events replace them as they are found.
+	// The network fee index starts at zero with NetworkFee, which matches the contract for a scan started at its
+	// deployment.
+	NetworkFee                   *big.Int
+	LiquidationThresholdPeriod   uint64
+	MinimumLiquidationCollateral *big.Int
+	// OnWarning is called for every cluster whose runway drops below WarnRunwayBlocks, it logs the risk when nil.
+	OnWarning func(LiquidationRisk)
+}
+
+// LiquidationRisk is the estimated state of a cluster at a given block.
+type LiquidationRisk struct {
+	Cluster Cluster
+	// Block the estimate was made for.
+	Block uint64
+	// BurnRate is the amount of SSV the cluster pays per block, operator and network fees included.
+	BurnRate *big.Int
+	// EstimatedBalance is the cluster balance at Block, computed like the contract from the growth of the operator and
+	// network fee indexes since the cluster snapshot. When an operator index is unknown, its part is extrapolated from
+	// the current operator fees since the snapshot block instead.
+	EstimatedBalance *big.Int
+	// LiquidationCollateral is the balance below which the cluster can be liquidated.
+	LiquidationCollateral *big.Int
+	// RunwayBlocks is the number of blocks left until the cluster can be liquidated, math.MaxUint64 if it burns nothing.
+	RunwayBlocks uint64
+	// UnknownOperators lists the cluster operators whose fee was never seen, the estimate treats them as free.
+	UnknownOperators []uint64
+}
+
+// LiquidationMonitor estimates the runway of the clusters of tracked owners from the snapshots of a ClusterTracker,
+// the operator fees of an OperatorRegistry and the network fee and liquidation parameters emitted by the contract.
+type LiquidationMonitor struct {
+	clusters         *ClusterTracker
+	operators        *OperatorRegistry
+	owners           []common.Address
+	warnRunwayBlocks uint64
+	onWarning        func(LiquidationRisk)
+
+	mu                           sync.Mutex
+	networkFee                   *big.Int
+	networkFeeIndex              *big.Int
+	networkFeeIndexBlock         uint64
+	liquidationThresholdPeriod   uint64
+	minimumLiquidationCollateral *big.Int
+	warned                       map[common.Hash]bool
+}
+
+// NewLiquidationMonitor creates a LiquidationMonitor over the snapshots kept by clusters and the fees kept by operators.
+func NewLiquidationMonitor(clusters *ClusterTracker, operators *OperatorRegistry, config LiquidationConfig) *LiquidationMonitor {
+	lm := &LiquidationMonitor{
+		clusters:                     clusters,
+		operators:                    operators,
+		owners:                       config.Owners,
+		warnRunwayBlocks:             config.WarnRunwayBlocks,
+		onWarning:                    config.OnWarning,
+		networkFee:                   new(big.Int),
+		networkFeeIndex:              new(big.Int),
+		liquidationThresholdPeriod:   config.LiquidationThresholdPeriod,
+		minimumLiquidationCollateral: new(big.Int),
+		warned:                       map[common.Hash]bool{},
+	}
+	if config.NetworkFee != nil {
+		lm.networkFee.Set(config.NetworkFee)
+	}
+	if config.MinimumLiquidationCollateral != nil {
+		lm.minimumLiquidationCollateral.Set(config.MinimumLiquidationCollateral)
+	}
+	if lm.onWarning == nil {
+		lm.onWarning = logLiquidationRisk
+	}
+	return lm
+}
+
+// Register subscribes the monitor to the network fee and liquidation parameter events.
+func (lm *LiquidationMonitor) Register(r *Registry) error {
+	if err := Handle(r, "NetworkFeeUpdated", func(e *SSVNetworkNetworkFeeUpdated, vLog types.Log) {
+		lm.mu.Lock()
+		defer lm.mu.Unlock()
+		// The contract moves the index to the update block before changing the fee
+		if vLog.BlockNumber > lm.networkFeeIndexBlock {
+			lm.networkFeeIndex = accrued(lm.networkFeeIndex, lm.networkFee, vLog.BlockNumber-lm.networkFeeIndexBlock)
+			lm.networkFeeIndexBlock = vLog.BlockNumber
+		}
+		lm.networkFee = new(big.Int).Set(e.NewFee)
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "LiquidationThresholdPeriodUpdated", func(e *SSVNetworkLiquidationThresholdPeriodUpdated, _ types.Log) {
+		lm.mu.Lock()
+		defer lm.mu.Unlock()
+		lm.liquidationThresholdPeriod = e.Value
+	}); err != nil {
+		return err
+	}
+	return Handle(r, "MinimumLiquidationCollateralUpdated", func(e *SSVNetworkMinimumLiquidationCollateralUpdated, _ types.Log) {
+		lm.mu.Lock()
+		defer lm.mu.Unlock()
+		lm.minimumLiquidationCollateral = new(big.Int).Set(e.Value)
+	})
+}
+
+// Risks estimates the runway at block of every active cluster of the tracked owners.
+func (lm *LiquidationMonitor) Risks(block uint64) []LiquidationRisk {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	var risks []LiquidationRisk
+	for _, owner := range lm.owners {
+		for _, cluster := range lm.clusters.Clusters(owner) {
+			if !cluster.Active {
+				continue
+			}
+			risks = append(risks, lm.estimate(cluster, block))
+		}
+	}
+	return risks
+}
+
+// Check estimates the runway at block of the tracked owners' clusters and warns about the ones below the
+// configured threshold. A cluster is reported once when it crosses the threshold, and again only after it recovered.
+// It returns the clusters currently below the threshold.
+func (lm *LiquidationMonitor) Check(block uint64) []LiquidationRisk {
+	var atRisk []LiquidationRisk
+	for _, risk := range lm.Risks(block) {
+		if risk.RunwayBlocks >= lm.warnRunwayBlocks {
+			lm.setWarned(risk.Cluster.ID, false)
+			continue
+		}
+
+		atRisk = append(atRisk, risk)
+		if !lm.setWarned(risk.Cluster.ID, true) {
+			lm.onWarning(risk)
+		}
+	}
+	return atRisk
+}
+
+// estimate computes the liquidation risk of cluster at block, the caller must hold the lock.
+func (lm *LiquidationMonitor) estimate(cluster Cluster, block uint64) LiquidationRisk {
+	risk := LiquidationRisk{
+		Cluster:          cluster,
+		Block:            block,
+		BurnRate:         new(big.Int).Set(lm.networkFee),
+		EstimatedBalance: new(big.Int),
+		RunwayBlocks:     math.MaxUint64,
+	}
+
+	// The contract indexes are shrunk by deductedDigits, the fees and balances are not
+	deducted := big.NewInt(deductedDigits)
+	usage := new(big.Int).Set(lm.networkFeeIndex)
+	if block > lm.networkFeeIndexBlock {
+		usage = accrued(lm.networkFeeIndex, lm.networkFee, block-lm.networkFeeIndexBlock)
+	}
+	usage.Sub(usage, new(big.Int).Mul(new(big.Int).SetUint64(cluster.NetworkFeeIndex), deducted))
+
+	operatorFees := new(big.Int)
+	operatorsIndex := new(big.Int)
+	indexed := true
+	for _, id := range cluster.OperatorIds {
+		fee, index := lm.operators.fee(id, block)
+		if fee == nil {
+			risk.UnknownOperators = append(risk.UnknownOperators, id)
+			indexed = false
+			continue
+		}
+		operatorFees.Add(operatorFees, fee)
+		if index == nil {
+			indexed = false
+			continue
+		}
+		operatorsIndex.Add(operatorsIndex, index)
+	}
+	if indexed {
+		usage.Add(usage, operatorsIndex.Sub(operatorsIndex, new(big.Int).Mul(new(big.Int).SetUint64(cluster.Index), deducted)))
+	} else if block > cluster.UpdatedBlock {
+		usage.Add(usage, new(big.Int).Mul(operatorFees, new(big.Int).SetUint64(block-cluster.UpdatedBlock)))
+	}
+
+	validators := new(big.Int).SetUint64(uint64(cluster.ValidatorCount))
+	risk.BurnRate.Add(risk.BurnRate, operatorFees)
+	risk.BurnRate.Mul(risk.BurnRate, validators)
+
+	if cluster.Balance != nil {
+		risk.EstimatedBalance.Set(cluster.Balance)
+	}
+	risk.EstimatedBalance.Sub(risk.EstimatedBalance, usage.Mul(usage, validators))
+	// The contract floors the balance at zero once the usage exceeds it
+	if risk.EstimatedBalance.Sign() < 0 {
+		risk.EstimatedBalance.SetInt64(0)
+	}
+
+	// The contract liquidates once the balance can't cover the threshold period, or is below the minimum collateral
+	risk.LiquidationCollateral = new(big.Int).Mul(risk.BurnRate, new(big.Int).SetUint64(lm.liquidationThresholdPeriod))
+	if risk.LiquidationCollateral.Cmp(lm.minimumLiquidationCollateral) < 0 {
+		risk.LiquidationCollateral.Set(lm.minimumLiquidationCollateral)
+	}
+
+	surplus := new(big.Int).Sub(risk.EstimatedBalance, risk.LiquidationCollateral)
+	switch {
+	case surplus.Sign() <= 0:
+		risk.RunwayBlocks = 0
+	case risk.BurnRate.Sign() > 0:
+		runway := surplus.Div(surplus, risk.BurnRate)
+		if runway.IsUint64() {
+			risk.RunwayBlocks = runway.Uint64()
+		}
+	}
+	return risk
+}
+
+// setWarned records whether a cluster is below the threshold and returns the previous state.
+func (lm *LiquidationMonitor) setWarned(clusterID common.Hash, warned bool) bool {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	previous := lm.warned[clusterID]
+	lm.warned[clusterID] = warned
+	return previous
+}
+
+// logLiquidationRisk is the default warning handler.
+func logLiquidationRisk(risk LiquidationRisk) {
+	slog.Warn("cluster can be liquidated soon", "cluster", risk.Cluster.ID.Hex(), "owner", risk.Cluster.Owner.Hex(),
+		"operator_ids", risk.Cluster.OperatorIds, "runway_blocks", risk.RunwayBlocks, "block", risk.Block,
+		"estimated_balance", risk.EstimatedBalance.String(), "burn_rate", risk.BurnRate.String(),
+		"unknown_operators", risk.UnknownOperators)
+}
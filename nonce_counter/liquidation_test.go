@@ -0,0 +1,206 @@
+package noncecounter
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ssv returns amount SSV units in the precision the contract keeps fees and balances in.
+func ssv(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(deductedDigits))
+}
+
+func TestLiquidationMonitorRisks(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+
+	// Operators 1 and 2 charge 10 and the network 5 since block 0, the cluster snapshot is taken at block 100
+	tests := []struct {
+		name             string
+		operatorIds      []uint64
+		validatorCount   uint32
+		clusterIndex     uint64
+		balance          int64
+		block            uint64
+		feeChange        bool
+		wantBurnRate     int64
+		wantBalance      int64
+		wantRunway       uint64
+		wantUnknownCount int
+	}{
+		{
+			name:           "healthy cluster",
+			operatorIds:    []uint64{1, 2},
+			validatorCount: 2,
+			clusterIndex:   2000,
+			balance:        100000,
+			block:          200,
+			wantBurnRate:   50,
+			wantBalance:    95000,
+			wantRunway:     1800,
+		},
+		{
+			name:           "operator fee changed since the snapshot",
+			operatorIds:    []uint64{1, 2},
+			validatorCount: 2,
+			clusterIndex:   2000,
+			balance:        100000,
+			block:          200,
+			feeChange:      true,
+			wantBurnRate:   70,
+			wantBalance:    94000,
+			wantRunway:     1242,
+		},
+		{
+			name:           "minimum collateral dominates",
+			operatorIds:    []uint64{1, 2},
+			validatorCount: 1,
+			clusterIndex:   2000,
+			balance:        5000,
+			block:          100,
+			wantBurnRate:   25,
+			wantBalance:    5000,
+			wantRunway:     80,
+		},
+		{
+			name:           "liquidatable cluster",
+			operatorIds:    []uint64{1, 2},
+			validatorCount: 2,
+			clusterIndex:   2000,
+			balance:        6000,
+			block:          200,
+			wantBurnRate:   50,
+			wantBalance:    1000,
+			wantRunway:     0,
+		},
+		{
+			name:             "unknown operator fee",
+			operatorIds:      []uint64{1, 3},
+			validatorCount:   1,
+			clusterIndex:     1000,
+			balance:          100000,
+			block:            100,
+			wantBurnRate:     15,
+			wantBalance:      100000,
+			wantRunway:       6466,
+			wantUnknownCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewClusterTracker()
+			tracker.update("ValidatorAdded", owner, tt.operatorIds, ISSVNetworkCoreCluster{
+				ValidatorCount:  tt.validatorCount,
+				NetworkFeeIndex: 500,
+				Index:           tt.clusterIndex,
+				Active:          true,
+				Balance:         ssv(tt.balance),
+			}, types.Log{BlockNumber: 100})
+
+			operators := NewOperatorRegistry()
+			for _, id := range []uint64{1, 2} {
+				operators.update(id, func(op *Operator) {
+					op.Fee = ssv(10)
+					op.Index = new(big.Int)
+				})
+			}
+			if tt.feeChange {
+				operators.update(1, func(op *Operator) {
+					op.accrue(150)
+					op.Fee = ssv(20)
+				})
+			}
+
+			lm := NewLiquidationMonitor(tracker, operators, LiquidationConfig{
+				Owners:                       []common.Address{owner},
+				NetworkFee:                   ssv(5),
+				LiquidationThresholdPeriod:   100,
+				MinimumLiquidationCollateral: ssv(3000),
+			})
+
+			risks := lm.Risks(tt.block)
+			if len(risks) != 1 {
+				t.Fatalf("len(Risks()) = %d, want 1", len(risks))
+			}
+			risk := risks[0]
+			if risk.BurnRate.Cmp(ssv(tt.wantBurnRate)) != 0 {
+				t.Errorf("BurnRate = %s, want %s", risk.BurnRate, ssv(tt.wantBurnRate))
+			}
+			if risk.EstimatedBalance.Cmp(ssv(tt.wantBalance)) != 0 {
+				t.Errorf("EstimatedBalance = %s, want %s", risk.EstimatedBalance, ssv(tt.wantBalance))
+			}
+			if risk.RunwayBlocks != tt.wantRunway {
+				t.Errorf("RunwayBlocks = %d, want %d", risk.RunwayBlocks, tt.wantRunway)
+			}
+			if len(risk.UnknownOperators) != tt.wantUnknownCount {
+				t.Errorf("UnknownOperators = %v, want %d entries", risk.UnknownOperators, tt.wantUnknownCount)
+			}
+		})
+	}
+}
+
+func TestLiquidationMonitorCheck(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	tracker := NewClusterTracker()
+	tracker.update("ValidatorAdded", owner, []uint64{1}, ISSVNetworkCoreCluster{
+		ValidatorCount: 1,
+		Active:         true,
+		Balance:        ssv(1000),
+	}, types.Log{BlockNumber: 0})
+	tracker.update("ValidatorAdded", common.Address{}, []uint64{1}, ISSVNetworkCoreCluster{
+		ValidatorCount: 1,
+		Active:         true,
+		Balance:        big.NewInt(0),
+	}, types.Log{BlockNumber: 0})
+
+	var warnings []LiquidationRisk
+	lm := NewLiquidationMonitor(tracker, NewOperatorRegistry(), LiquidationConfig{
+		Owners:           []common.Address{owner},
+		WarnRunwayBlocks: 500,
+		NetworkFee:       ssv(1),
+		OnWarning: func(risk LiquidationRisk) {
+			warnings = append(warnings, risk)
+		},
+	})
+
+	checks := []struct {
+		block        uint64
+		wantAtRisk   int
+		wantWarnings int
+	}{
+		{block: 100, wantAtRisk: 0, wantWarnings: 0},
+		{block: 600, wantAtRisk: 1, wantWarnings: 1},
+		{block: 700, wantAtRisk: 1, wantWarnings: 1},
+	}
+	for _, check := range checks {
+		if got := len(lm.Check(check.block)); got != check.wantAtRisk {
+			t.Errorf("len(Check(%d)) = %d, want %d", check.block, got, check.wantAtRisk)
+		}
+		if len(warnings) != check.wantWarnings {
+			t.Errorf("warnings after Check(%d) = %d, want %d", check.block, len(warnings), check.wantWarnings)
+		}
+	}
+
+	// Topping the cluster up re-arms the warning
+	tracker.update("ClusterDeposited", owner, []uint64{1}, ISSVNetworkCoreCluster{
+		ValidatorCount:  1,
+		NetworkFeeIndex: 800,
+		Active:          true,
+		Balance:         ssv(math.MaxInt32),
+	}, types.Log{BlockNumber: 800})
+	lm.Check(800)
+	tracker.update("ClusterWithdrawn", owner, []uint64{1}, ISSVNetworkCoreCluster{
+		ValidatorCount:  1,
+		NetworkFeeIndex: 900,
+		Active:          true,
+		Balance:         ssv(10),
+	}, types.Log{BlockNumber: 900})
+	lm.Check(900)
+	if len(warnings) != 2 {
+		t.Errorf("warnings after recovery = %d, want 2", len(warnings))
+	}
+}
@@ -0,0 +1,84 @@
+package noncecounter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+)
+
+// DefaultNATSSubject is the subject prefix of the published ValidatorAdded events.
+const DefaultNATSSubject = "ssv.validator_added"
+
+// NATSConfig configures a NATSPublisher.
+type NATSConfig struct {
+	URL string
+	// Subject is the prefix of the subjects messages are published on, followed by the message key, DefaultNATSSubject
+	// when empty.
+	Subject string
+	// JetStream publishes to a JetStream stream capturing the subjects and waits for its acknowledgment. Core NATS
+	// publications are only confirmed to have reached the server.
+	JetStream bool
+}
+
+// NATSPublisher publishes messages to NATS, on the subject prefix followed by the message key. The message ID is
+// sent in the Nats-Msg-Id header, for JetStream to drop republished messages.
+type NATSPublisher struct {
+	conn    *nats.Conn
+	js      nats.JetStreamContext
+	subject string
+}
+
+// NewNATSPublisher connects to the NATS server of config.
+func NewNATSPublisher(config NATSConfig) (*NATSPublisher, error) {
+	conn, err := nats.Connect(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	p := &NATSPublisher{conn: conn, subject: config.Subject}
+	if p.subject == "" {
+		p.subject = DefaultNATSSubject
+	}
+	if config.JetStream {
+		p.js, err = conn.JetStream()
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to open JetStream context: %w", err)
+		}
+	}
+	return p, nil
+}
+
+// Publish publishes the messages and waits until the server received them, or JetStream acknowledged them.
+func (p *NATSPublisher) Publish(ctx context.Context, messages []Message) error {
+	for _, message := range messages {
+		msg := nats.NewMsg(p.subject + "." + message.Key)
+		msg.Header.Set(nats.MsgIdHdr, message.ID)
+		msg.Header.Set("Key", message.Key)
+		msg.Data = message.Value
+
+		if p.js != nil {
+			if _, err := p.js.PublishMsg(msg, nats.Context(ctx)); err != nil {
+				return fmt.Errorf("failed to publish message %s: %w", message.ID, err)
+			}
+			continue
+		}
+		if err := p.conn.PublishMsg(msg); err != nil {
+			return fmt.Errorf("failed to publish message %s: %w", message.ID, err)
+		}
+	}
+	if p.js != nil {
+		return nil
+	}
+	// FlushWithContext requires a deadline, Flush applies the default timeout
+	if _, ok := ctx.Deadline(); !ok {
+		return p.conn.Flush()
+	}
+	return p.conn.FlushWithContext(ctx)
+}
+
+// Close drains and closes the connection.
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
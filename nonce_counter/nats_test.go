@@ -0,0 +1,115 @@
+package noncecounter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+)
+
+// runNATSServer starts an embedded NATS server with JetStream on a random port.
+func runNATSServer(t *testing.T) *server.Server {
+	t.Helper()
+
+	ns, err := server.NewServer(&server.Options{Host: "127.0.0.1", Port: -1, JetStream: true, StoreDir: t.TempDir(), NoLog: true, NoSigs: true})
+	if err != nil {
+		t.Fatalf("failed to create NATS server: %v", err)
+	}
+	ns.Start()
+	t.Cleanup(ns.Shutdown)
+	if !ns.ReadyForConnections(5 * time.Second) {
+		t.Fatalf("NATS server did not start")
+	}
+	return ns
+}
+
+func TestNATSPublisher(t *testing.T) {
+	ns := runNATSServer(t)
+	messages := []Message{
+		{Key: "0xA", ID: "1-0", Value: []byte(`{"owner":"0xA"}`)},
+		{Key: "0xB", ID: "1-1", Value: []byte(`{"owner":"0xB"}`)},
+	}
+
+	t.Run("core", func(t *testing.T) {
+		conn, err := nats.Connect(ns.ClientURL())
+		if err != nil {
+			t.Fatalf("failed to connect to NATS: %v", err)
+		}
+		defer conn.Close()
+		sub, err := conn.SubscribeSync(DefaultNATSSubject + ".>")
+		if err != nil {
+			t.Fatalf("failed to subscribe: %v", err)
+		}
+		if err := conn.Flush(); err != nil {
+			t.Fatalf("failed to flush subscription: %v", err)
+		}
+
+		publisher, err := NewNATSPublisher(NATSConfig{URL: ns.ClientURL()})
+		if err != nil {
+			t.Fatalf("NewNATSPublisher() error = %v", err)
+		}
+		defer publisher.Close()
+		if err := publisher.Publish(context.Background(), messages); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+
+		for _, want := range messages {
+			msg, err := sub.NextMsg(5 * time.Second)
+			if err != nil {
+				t.Fatalf("NextMsg() error = %v", err)
+			}
+			if msg.Subject != DefaultNATSSubject+"."+want.Key || msg.Header.Get(nats.MsgIdHdr) != want.ID ||
+				msg.Header.Get("Key") != want.Key || string(msg.Data) != string(want.Value) {
+				t.Errorf("message = %s %v %s, want %+v", msg.Subject, msg.Header, msg.Data, want)
+			}
+		}
+	})
+
+	t.Run("JetStream drops republished messages", func(t *testing.T) {
+		conn, err := nats.Connect(ns.ClientURL())
+		if err != nil {
+			t.Fatalf("failed to connect to NATS: %v", err)
+		}
+		defer conn.Close()
+		js, err := conn.JetStream()
+		if err != nil {
+			t.Fatalf("failed to open JetStream context: %v", err)
+		}
+		if _, err := js.AddStream(&nats.StreamConfig{Name: "SSV", Subjects: []string{"ssv.js.>"}}); err != nil {
+			t.Fatalf("failed to add stream: %v", err)
+		}
+
+		publisher, err := NewNATSPublisher(NATSConfig{URL: ns.ClientURL(), Subject: "ssv.js", JetStream: true})
+		if err != nil {
+			t.Fatalf("NewNATSPublisher() error = %v", err)
+		}
+		defer publisher.Close()
+		for i := 0; i < 2; i++ {
+			if err := publisher.Publish(context.Background(), messages); err != nil {
+				t.Fatalf("Publish() error = %v", err)
+			}
+		}
+
+		info, err := js.StreamInfo("SSV")
+		if err != nil {
+			t.Fatalf("StreamInfo() error = %v", err)
+		}
+		if info.State.Msgs != uint64(len(messages)) {
+			t.Errorf("stream holds %d messages, want %d", info.State.Msgs, len(messages))
+		}
+
+		// Without a stream capturing the subject the publication is not acknowledged
+		unrouted, err := NewNATSPublisher(NATSConfig{URL: ns.ClientURL(), Subject: "ssv.unrouted", JetStream: true})
+		if err != nil {
+			t.Fatalf("NewNATSPublisher() error = %v", err)
+		}
+		defer unrouted.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		if err := unrouted.Publish(ctx, messages); err == nil {
+			t.Errorf("Publish() without a stream succeeded")
+		}
+	})
+}
@@ -2,18 +2,23 @@ package noncecounter
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"io"
+	"log/slog"
 	"math/big"
-	"strings"
+	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/slices"
 	"golang.org/x/sync/semaphore"
 )
@@ -28,111 +33,396 @@ type NonceCounter struct {
 	blockBatchSize  int64
 	mu              sync.Mutex
 	concurrency     int64
+	strictDecoding  bool
+	decodeErrors    atomic.Uint64
+	registry        *Registry
+	nonceChanged    atomic.Bool
+	verifyShares    bool
+	shareMismatches atomic.Uint64
+	// reservations are guarded by mu, like the nonces they are allocated from
+	reservations     []Reservation
+	reservationsPath string
+	reservationTTL   time.Duration
+	now              func() time.Time
+	synced           atomic.Bool
+	pendingNonces    bool
+	// pending counts the registrations of every tracked owner waiting in the pending block, guarded by mu
+	pending              map[string]uint64
+	attributeSubmissions bool
+	// submissions and the queue of the ones not fetched yet are guarded by mu
+	submissions  map[common.Hash]*Submission
+	unattributed []common.Hash
+	// history is guarded by mu, increments from index stamped on wait for their block timestamp
+	history         []Increment
+	stamped         int
+	blockTimestamps bool
+	untilBlock      uint64
+	deploymentBlock uint64
+	// complete is set while the nonces account for every event since the contract deployment
+	complete atomic.Bool
+	// lastBlock is the last block processed, valid once processed is set
+	lastBlock  atomic.Uint64
+	processed  atomic.Bool
+	snapshot   *Snapshot
+	recordPath string
+	output     io.Writer
+	batchHooks []func(Batch)
+	// deliverHooks hand the events of a block range downstream, checkpointHooks persist it once they all did
+	deliverHooks    []func(Batch) error
+	checkpointHooks []func(Batch)
+	// watchers are guarded by mu
+	watchers         map[*watcher]struct{}
+	decodeErrorHooks []func(DecodeError)
+	logger           *slog.Logger
+	tracer           trace.Tracer
+}
+
+// Batch describes a block range the counter has finished processing.
+type Batch struct {
+	FromBlock uint64
+	ToBlock   uint64
+	Logs      int
+	// AtHead is set when the block range ends at the chain head, so the state after it is current rather than part of
+	// a backfill.
+	AtHead bool
 }
 
 // Config represents the configuration required for initializing and managing a nonce counter.
 type Config struct {
 	Concurrency     int64
 	ContractAddress string
-	ContractABI     string
+	// ContractABI is the contract ABI in any of the formats accepted by ParseContractABI.
+	ContractABI string
+	// ContractABIPath is a file to load the contract ABI from instead of ContractABI.
+	ContractABIPath string
 	StartBlock      int64
-	EventName       string
-	Addresses       []string
-	BlockBatchSize  int64
+	// DeploymentBlock is the block the contract was deployed at. Nonces are only complete, and TakeSnapshot only
+	// succeeds, when the scan starts at or before it or resumes from a complete snapshot or checkpoint.
+	DeploymentBlock uint64
+	// EventName is the event consuming owner nonces, only ValidatorAdded is supported.
+	EventName      string
+	Addresses      []string
+	BlockBatchSize int64
+	// StrictDecoding makes a log that matches the event but fails to decode a fatal error instead of a counted one.
+	StrictDecoding bool
+	// VerifyShares checks that the shares signature of every tracked owner's registration signs the counted nonce.
+	VerifyShares bool
+	// ReservationTTL is how long nonce reservations last, 15 minutes when not set.
+	ReservationTTL time.Duration
+	// ReservationsPath is a file to persist nonce reservations to so they survive restarts, they are kept in memory
+	// only when not set.
+	ReservationsPath string
+	// PendingNonces decodes the registrations waiting in the pending block once the counter follows the chain head,
+	// so PendingNonce accounts for submitted but not yet included transactions.
+	PendingNonces bool
+	// AttributeSubmissions fetches the transaction of every tracked owner's registration and decodes its calldata,
+	// so nonce increments can be grouped by the submission that caused them.
+	AttributeSubmissions bool
+	// BlockTimestamps fetches the header of every block with a nonce increment, so the history carries block times.
+	BlockTimestamps bool
+	// UntilBlock is the last block Start and Sync process, they follow the chain head when it is 0.
+	UntilBlock uint64
+	// SnapshotPath is a snapshot file to bootstrap the nonces from, scanning resumes after its block once the block is
+	// found canonical. Every configured address must be in the snapshot.
+	SnapshotPath string
+	// SnapshotSigner is the address the snapshot must be signed by, unsigned snapshots are accepted when not set.
+	SnapshotSigner string
+	// RecordPath is a JSONL file every log fetched by Start and Sync is appended to, followed by a marker of the block
+	// range it was fetched in, so the run can be replayed offline with Replay.
+	RecordPath string
+	// Output is where nonce changes are printed, os.Stdout when not set.
+	Output io.Writer
+	// Logger receives the structured logs of the counter and the subsystems attached to it, slog.Default() when not
+	// set.
+	Logger *slog.Logger
+	// TracerProvider receives the spans of the scanned block ranges, the RPC calls and the decoding of their logs, the
+	// global tracer provider when not set.
+	TracerProvider trace.TracerProvider
 }
 
 // Validate checks the Config fields for validity and returns an error if any required field is invalid or missing.
+// The contract ABI is loaded as part of it, so the configured event can be checked against it.
 func (ncc Config) Validate() error {
+	_, err := ncc.validate()
+	return err
+}
+
+// validate checks the Config fields for validity and returns the loaded contract ABI.
+func (ncc Config) validate() (abi.ABI, error) {
 	if ncc.Concurrency <= 0 {
-		return fmt.Errorf("concurrency must be greater than 0")
+		return abi.ABI{}, fmt.Errorf("concurrency must be greater than 0")
 	}
 	if ncc.ContractAddress == "" {
-		return fmt.Errorf("contract address must be provided")
+		return abi.ABI{}, fmt.Errorf("contract address must be provided")
+	}
+	if ncc.ContractABI == "" && ncc.ContractABIPath == "" {
+		return abi.ABI{}, fmt.Errorf("contract ABI or contract ABI path must be provided")
 	}
-	if ncc.ContractABI == "" {
-		return fmt.Errorf("contract ABI must be provided")
+	if ncc.ContractABI != "" && ncc.ContractABIPath != "" {
+		return abi.ABI{}, fmt.Errorf("only one of contract ABI and contract ABI path can be provided")
 	}
 	if ncc.StartBlock < 0 {
-		return fmt.Errorf("start block must be greater than or equal to 0")
+		return abi.ABI{}, fmt.Errorf("start block must be greater than or equal to 0")
 	}
 	if ncc.EventName == "" {
-		return fmt.Errorf("event name must be provided")
+		return abi.ABI{}, fmt.Errorf("event name must be provided")
+	}
+	// Nonces are decoded and counted from validator registrations, other events would be dispatched to the same handler
+	if ncc.EventName != "ValidatorAdded" {
+		return abi.ABI{}, fmt.Errorf("event %s is not supported, only ValidatorAdded consumes owner nonces", ncc.EventName)
 	}
 	if len(ncc.Addresses) == 0 {
-		return fmt.Errorf("addresses must be provided")
+		return abi.ABI{}, fmt.Errorf("addresses must be provided")
+	}
+	for _, address := range ncc.Addresses {
+		if !common.IsHexAddress(address) {
+			return abi.ABI{}, fmt.Errorf("address %q is not a valid hex address", address)
+		}
 	}
 	if ncc.BlockBatchSize <= 0 {
-		return fmt.Errorf("block batch size must be greater than 0")
+		return abi.ABI{}, fmt.Errorf("block batch size must be greater than 0")
+	}
+	if ncc.UntilBlock != 0 && ncc.UntilBlock < uint64(max(ncc.StartBlock, 0)) {
+		return abi.ABI{}, fmt.Errorf("until block must be greater than or equal to the start block")
+	}
+	if ncc.SnapshotSigner != "" {
+		if ncc.SnapshotPath == "" {
+			return abi.ABI{}, fmt.Errorf("snapshot signer requires a snapshot path")
+		}
+		if !common.IsHexAddress(ncc.SnapshotSigner) {
+			return abi.ABI{}, fmt.Errorf("snapshot signer %q is not a valid hex address", ncc.SnapshotSigner)
+		}
+	}
+	if ncc.ReservationTTL < 0 {
+		return abi.ABI{}, fmt.Errorf("reservation TTL must be greater than or equal to 0")
 	}
 
-	return nil
+	var contractAbi abi.ABI
+	var err error
+	if ncc.ContractABIPath != "" {
+		contractAbi, err = LoadContractABI(ncc.ContractABIPath)
+	} else {
+		contractAbi, err = ParseContractABI([]byte(ncc.ContractABI))
+	}
+	if err != nil {
+		return abi.ABI{}, err
+	}
+	if err := validateOwnerEvent(contractAbi, ncc.EventName); err != nil {
+		return abi.ABI{}, err
+	}
+
+	return contractAbi, nil
 }
 
 // NewNonceCounter initializes a NonceCounter instance using the provided configuration.
 // It validates the configuration and sets up the necessary internal state for nonce management.
 func NewNonceCounter(config Config) (*NonceCounter, error) {
-	if err := config.Validate(); err != nil {
-		return nil, err
-	}
-
-	contractAbi, err := abi.JSON(strings.NewReader(config.ContractABI))
+	contractAbi, err := config.validate()
 	if err != nil {
-		log.Fatalf("failed to parse contract ABI: %v", err)
+		return nil, err
 	}
 
+	// Owners are kept in checksummed form, which is how event owners are looked up
+	addresses := make([]string, 0, len(config.Addresses))
 	addressToNonce := make(map[string]uint64, len(config.Addresses))
 	for _, address := range config.Addresses {
-		addressToNonce[address] = 0
+		owner := normalizeOwner(address)
+		if _, ok := addressToNonce[owner]; ok {
+			continue
+		}
+		addresses = append(addresses, owner)
+		addressToNonce[owner] = 0
+	}
+
+	nc := &NonceCounter{
+		contractAddress:      config.ContractAddress,
+		eventName:            config.EventName,
+		contractAbi:          contractAbi,
+		addresses:            addresses,
+		blockBatchSize:       config.BlockBatchSize,
+		addressToNonce:       addressToNonce,
+		concurrency:          config.Concurrency,
+		strictDecoding:       config.StrictDecoding,
+		verifyShares:         config.VerifyShares,
+		reservationsPath:     config.ReservationsPath,
+		reservationTTL:       config.ReservationTTL,
+		pendingNonces:        config.PendingNonces,
+		attributeSubmissions: config.AttributeSubmissions,
+		submissions:          map[common.Hash]*Submission{},
+		blockTimestamps:      config.BlockTimestamps,
+		untilBlock:           config.UntilBlock,
+		deploymentBlock:      config.DeploymentBlock,
+		recordPath:           config.RecordPath,
+		output:               config.Output,
+		logger:               config.Logger,
+		tracer:               newTracer(config.TracerProvider),
+		now:                  time.Now,
+		mu:                   sync.Mutex{},
+		registry:             NewRegistry(contractAbi),
+	}
+	if nc.reservationTTL == 0 {
+		nc.reservationTTL = defaultReservationTTL
+	}
+	if err := nc.loadReservations(); err != nil {
+		return nil, err
 	}
+	if config.SnapshotPath != "" {
+		snapshot, err := LoadSnapshot(config.SnapshotPath)
+		if err != nil {
+			return nil, err
+		}
+		var signer *common.Address
+		if config.SnapshotSigner != "" {
+			address := common.HexToAddress(config.SnapshotSigner)
+			signer = &address
+		}
+		if err := nc.applySnapshot(snapshot, signer); err != nil {
+			return nil, fmt.Errorf("invalid snapshot: %w", err)
+		}
+	}
+	if err := Handle(nc.registry, config.EventName, nc.handleValidatorAdded); err != nil {
+		return nil, err
+	}
+
+	return nc, nil
+}
 
-	return &NonceCounter{
-		contractAddress: config.ContractAddress,
-		eventName:       config.EventName,
-		contractAbi:     contractAbi,
-		addresses:       config.Addresses,
-		blockBatchSize:  config.BlockBatchSize,
-		addressToNonce:  addressToNonce,
-		concurrency:     config.Concurrency,
-		mu:              sync.Mutex{},
-	}, nil
+// Registry returns the event registry the counter dispatches scanned logs through. Subsystems
+// interested in other contract events subscribe to it with Handle before Start is called.
+func (nc *NonceCounter) Registry() *Registry {
+	return nc.registry
 }
 
+// headPollInterval is how long Start waits for new blocks once it caught up with the chain head.
+const headPollInterval = 12 * time.Second
+
+// rpcRetryInterval is how long Start waits before retrying a failed RPC call.
+const rpcRetryInterval = 5 * time.Second
+
 // Start begins tracking and processing blockchain events from a specified start block using the provided RPC URL and context.
+// Once it catches up with the chain head it keeps following it until the context is cancelled, or returns once it
+// processed UntilBlock when set.
 func (nc *NonceCounter) Start(ctx context.Context, startBlock uint64, rpcURL string) error {
+	_, err := nc.scan(ctx, startBlock, rpcURL, true)
+	return err
+}
+
+// Sync processes blockchain events from a specified start block up to the chain head, or UntilBlock when set, and
+// returns the last block it processed, leaving the counter in the state the contract had at that block.
+func (nc *NonceCounter) Sync(ctx context.Context, startBlock uint64, rpcURL string) (uint64, error) {
+	return nc.scan(ctx, startBlock, rpcURL, false)
+}
+
+// scan processes block ranges from startBlock on, either following the chain head or returning once it reached it.
+// Every block range is traced in a span named after the calling method.
+func (nc *NonceCounter) scan(ctx context.Context, startBlock uint64, rpcURL string, follow bool) (uint64, error) {
+	spanName := "NonceCounter.Sync"
+	if follow {
+		spanName = "NonceCounter.Start"
+	}
+
 	client, err := ethclient.Dial(rpcURL)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer client.Close()
 
+	startBlock, err = nc.resumeFromSnapshot(ctx, client, startBlock)
+	if err != nil {
+		return 0, err
+	}
+	// Counts started after the deployment, or resumed past the block they were at, miss the events in between
+	if last, ok := nc.LastBlock(); !ok {
+		nc.complete.Store(startBlock <= nc.deploymentBlock)
+	} else if startBlock > last+1 {
+		nc.complete.Store(false)
+	}
+
+	var clock timeSource
+	if nc.blockTimestamps {
+		clock = NewBlockClock(client.Client())
+	}
+
 	currentBlock := new(big.Int).Set(big.NewInt(int64(startBlock)))
+	lastBlock := func() uint64 {
+		if currentBlock.Sign() == 0 {
+			return 0
+		}
+		return currentBlock.Uint64() - 1
+	}
 
 	for {
 		select {
 		case <-ctx.Done():
-			return nil
+			return lastBlock(), nil
 		default:
 			// Query the latest block number
 			header, err := client.HeaderByNumber(context.Background(), nil)
 			if err != nil {
-				log.Printf("failed to fetch block header: %v\n", err)
+				nc.Logger().Warn("failed to fetch block header, retrying", "retry_in", rpcRetryInterval, "error", err)
 				// On production code, the error should be handled properly and the retry and an exponential backoff should be implemented
-				time.Sleep(5 * time.Second)
+				time.Sleep(rpcRetryInterval)
+				break
+			}
+
+			// Blocks past the until block are never processed, it acts as the chain head
+			untilReached := nc.untilBlock != 0 && header.Number.Uint64() >= nc.untilBlock
+			if untilReached {
+				header = &types.Header{Number: new(big.Int).SetUint64(nc.untilBlock)}
+			}
+
+			// Every block up to the head was processed already, rescanning it would count its events twice
+			if currentBlock.Cmp(header.Number) > 0 {
+				// A scan stopped at UntilBlock reached historical data, not the chain head
+				if !untilReached {
+					nc.synced.Store(true)
+					if nc.pendingNonces {
+						nc.refreshPending(ctx, client, header.Number.Uint64())
+					}
+				}
+				if !follow || untilReached {
+					return lastBlock(), nil
+				}
+				time.Sleep(headPollInterval)
 				break
 			}
 
-			query := nc.prepareQuery(header, currentBlock)
-			fmt.Printf("Block Range %d-%d\n", query.FromBlock.Int64(), query.ToBlock.Int64())
-			logs, err := client.FilterLogs(context.Background(), query)
+			// Every block range gets its own trace, following the head would otherwise never end the span
+			spanCtx, span := nc.startSpan(ctx, spanName, trace.WithNewRoot(),
+				trace.WithAttributes(headBlockKey.Int64(header.Number.Int64())))
+			query := nc.prepareQuery(spanCtx, header, currentBlock)
+			span.SetAttributes(blockRangeAttributes(query.FromBlock.Uint64(), query.ToBlock.Uint64())...)
+			nc.Logger().Debug("fetching block range", "from_block", query.FromBlock.Uint64(), "to_block", query.ToBlock.Uint64())
+			logs, err := nc.filterLogs(spanCtx, client, query)
 			if err != nil {
-				log.Printf("error fetching logs for block %d: %v", currentBlock.Int64(), err)
+				endSpan(span, err)
+				nc.Logger().Warn("failed to fetch logs, retrying", "from_block", query.FromBlock.Uint64(),
+					"to_block", query.ToBlock.Uint64(), "retry_in", rpcRetryInterval, "error", err)
 				// On production code, the error should be handled properly and the retry and an exponential backoff should be implemented
-				time.Sleep(5 * time.Second)
+				time.Sleep(rpcRetryInterval)
 				break
 			}
+			span.SetAttributes(logCountKey.Int(len(logs)))
+			batch := Batch{
+				FromBlock: query.FromBlock.Uint64(),
+				ToBlock:   query.ToBlock.Uint64(),
+				Logs:      len(logs),
+				AtHead:    !untilReached && query.ToBlock.Cmp(header.Number) == 0,
+			}
+			if err := nc.recordLogs(logs); err != nil {
+				endSpan(span, err)
+				return lastBlock(), err
+			}
 
-			if foundAddress := nc.FindNonces(ctx, logs); foundAddress {
-				nc.printNonces()
+			// The range marker is recorded once the enrichments ran, with what they fetched
+			enrichment := newRangeEnrichment(clock, client)
+			err = nc.processBatch(spanCtx, batch, logs, func() error {
+				nc.enrich(ctx, enrichment)
+				return nc.recordRange(batch, enrichment)
+			})
+			endSpan(span, err)
+			if err != nil {
+				return lastBlock(), err
 			}
 
 			// Move to the next block range
@@ -141,50 +431,271 @@ func (nc *NonceCounter) Start(ctx context.Context, startBlock uint64, rpcURL str
 	}
 }
 
+// filterLogs fetches the logs matching query, in a span carrying the block range and the number of logs fetched.
+func (nc *NonceCounter) filterLogs(ctx context.Context, client *ethclient.Client, query ethereum.FilterQuery) ([]types.Log, error) {
+	_, span := nc.startSpan(ctx, "FilterLogs", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(blockRangeAttributes(query.FromBlock.Uint64(), query.ToBlock.Uint64())...))
+	// The call keeps a background context, a cancelled scan still finishes the block range it started
+	logs, err := client.FilterLogs(context.Background(), query)
+	span.SetAttributes(logCountKey.Int(len(logs)))
+	endSpan(span, err)
+	return logs, err
+}
+
+// processBatch counts the nonces of the logs of a block range and prints the resulting changes. enrich runs once the
+// logs were dispatched, before anything is printed, to complete the recorded increments and submissions.
+func (nc *NonceCounter) processBatch(ctx context.Context, batch Batch, logs []types.Log, enrich func() error) error {
+	recorded := nc.historyLen()
+	foundAddress, err := nc.FindNonces(ctx, logs)
+	if err != nil {
+		return err
+	}
+	if enrich != nil {
+		if err := enrich(); err != nil {
+			return err
+		}
+	}
+	nc.printIncrements(recorded)
+	nc.notifyWatchers(recorded)
+	if foundAddress {
+		nc.settlePending(recorded)
+		nc.printNonces()
+	}
+
+	nc.lastBlock.Store(batch.ToBlock)
+	nc.processed.Store(true)
+	nc.Logger().Info("processed block range", "from_block", batch.FromBlock, "to_block", batch.ToBlock,
+		"log_count", batch.Logs, "increments", nc.historyLen()-recorded)
+	for _, hook := range nc.batchHooks {
+		hook(batch)
+	}
+
+	// Every deliver hook runs, so each retries what it holds even when another failed
+	var deliverErrs []error
+	for _, hook := range nc.deliverHooks {
+		if err := hook(batch); err != nil {
+			deliverErrs = append(deliverErrs, err)
+		}
+	}
+	if err := errors.Join(deliverErrs...); err != nil {
+		nc.Logger().Error("failed to deliver block range, withholding its checkpoint", "from_block", batch.FromBlock,
+			"to_block", batch.ToBlock, "error", err)
+		return nil
+	}
+	for _, hook := range nc.checkpointHooks {
+		hook(batch)
+	}
+	return nil
+}
+
+// out returns the writer the counter prints nonce changes to.
+func (nc *NonceCounter) out() io.Writer {
+	if nc.output == nil {
+		return os.Stdout
+	}
+	return nc.output
+}
+
+// Logger returns the logger of the counter, for the subsystems attached to it.
+func (nc *NonceCounter) Logger() *slog.Logger {
+	if nc.logger == nil {
+		return slog.Default()
+	}
+	return nc.logger
+}
+
+// LastBlock returns the last block Start or Sync processed, and false if they did not process any yet.
+func (nc *NonceCounter) LastBlock() (uint64, bool) {
+	return nc.lastBlock.Load(), nc.processed.Load()
+}
+
+// Complete reports whether the nonces account for every event since the contract deployment, as the scan started at or
+// before DeploymentBlock or resumed from a complete snapshot or checkpoint without skipping blocks.
+func (nc *NonceCounter) Complete() bool {
+	return nc.complete.Load()
+}
+
+// Synced reports whether the counter caught up with the chain head at least once, so its nonces are current. Scans
+// stopped at UntilBlock before the head never are.
+func (nc *NonceCounter) Synced() bool {
+	return nc.synced.Load()
+}
+
+// OnBatch registers hook to be called by Start after every block range it processes, once all its logs were dispatched.
+// Hooks must be registered before Start is called.
+func (nc *NonceCounter) OnBatch(hook func(Batch)) {
+	nc.batchHooks = append(nc.batchHooks, hook)
+}
+
+// OnDeliver registers hook to be called after the OnBatch hooks of every block range, to hand its events over to a
+// system outside the counter. An error means they were not durably handed over, the hook has to retry them with the
+// next block range and the OnCheckpoint hooks are skipped until every deliver hook succeeds. Hooks must be registered
+// before Start is called.
+func (nc *NonceCounter) OnDeliver(hook func(Batch) error) {
+	nc.deliverHooks = append(nc.deliverHooks, hook)
+}
+
+// OnCheckpoint registers hook to be called once every OnDeliver hook handed a block range over, to persist the point
+// scanning resumes from, so a resumed counter never skips events that were not delivered. Hooks must be registered
+// before Start is called.
+func (nc *NonceCounter) OnCheckpoint(hook func(Batch)) {
+	nc.checkpointHooks = append(nc.checkpointHooks, hook)
+}
+
+// DecodeError is a log of a registered event that failed to decode.
+type DecodeError struct {
+	Event string
+	Log   types.Log
+	Err   error
+}
+
+// OnDecodeError registers hook to be called, in log order, for every log FindNonces fails to decode. Hooks must be
+// registered before Start is called.
+func (nc *NonceCounter) OnDecodeError(hook func(DecodeError)) {
+	nc.decodeErrorHooks = append(nc.decodeErrorHooks, hook)
+}
+
 // FindNonces processes blockchain logs to identify relevant events, increment
-// nonces for tracked addresses, and returns success.
-func (nc *NonceCounter) FindNonces(ctx context.Context, logs []types.Log) bool {
-	foundAddress := false
+// nonces for tracked addresses, and returns whether any tracked nonce changed.
+// Logs are decoded concurrently and then dispatched to the registry handlers in
+// log order. Logs of registered events that fail to decode are logged and
+// counted, and in strict decoding mode the first such failure is returned as an error.
+func (nc *NonceCounter) FindNonces(ctx context.Context, logs []types.Log) (found bool, err error) {
+	ctx, span := nc.startSpan(ctx, "NonceCounter.FindNonces", trace.WithAttributes(logCountKey.Int(len(logs))))
+	defer func() {
+		span.SetAttributes(nonceChangedKey.Bool(found))
+		endSpan(span, err)
+	}()
+
+	decoded := make([]any, len(logs))
+	entries := make([]*registryEntry, len(logs))
+	failures := make([]*DecodeError, len(logs))
 
 	sem := semaphore.NewWeighted(nc.concurrency)
 	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var decodeErr error
+
+	for i, vLog := range logs {
+		entry := nc.registry.lookup(vLog)
+		if entry == nil {
+			// Nobody subscribed to this event
+			continue
+		}
 
-	for _, vLog := range logs {
 		wg.Add(1)
 
 		if err := sem.Acquire(ctx, 1); err != nil {
-			log.Printf("failed to acquire semaphore: %v\n", err)
+			nc.Logger().Error("failed to acquire semaphore", "error", err)
 			wg.Done()
 			continue
 		}
 
-		go func(vLog types.Log) {
+		go func(i int, vLog types.Log) {
 			defer wg.Done()
 			defer sem.Release(1)
 
-			event := &ValidatorAddedEvent{}
-			if err := event.Parse(nc.eventName, nc.contractAbi, vLog); err != nil {
-				// This should be handled properly in production code, for now just ignore it and move on
-				return
-			}
-
-			// Process the event
-			if incremented := nc.incrementNonce(*event); !incremented {
+			event, err := entry.decode(vLog)
+			if err != nil {
+				nc.decodeErrors.Add(1)
+				nc.Logger().Error("failed to decode log", "event", entry.name, "block", vLog.BlockNumber,
+					"tx_hash", vLog.TxHash.Hex(), "log_index", vLog.Index, "error", err)
+				errOnce.Do(func() {
+					decodeErr = fmt.Errorf("tx %s, log index %d: %w", vLog.TxHash.Hex(), vLog.Index, err)
+				})
+				failures[i] = &DecodeError{Event: entry.name, Log: vLog, Err: err}
 				return
 			}
 
-			if !foundAddress {
-				foundAddress = true
-			}
-		}(vLog)
+			decoded[i] = event
+			entries[i] = entry
+		}(i, vLog)
 	}
 	wg.Wait()
 
-	return foundAddress
+	failed := 0
+	for _, failure := range failures {
+		if failure == nil {
+			continue
+		}
+		failed++
+		for _, hook := range nc.decodeErrorHooks {
+			hook(*failure)
+		}
+	}
+
+	nc.nonceChanged.Store(false)
+	for i, event := range decoded {
+		if event != nil {
+			entries[i].dispatch(event, logs[i])
+		}
+	}
+	foundAddress := nc.nonceChanged.Load()
+	span.SetAttributes(decodeErrorsKey.Int(failed))
+
+	if nc.strictDecoding && decodeErr != nil {
+		return foundAddress, decodeErr
+	}
+
+	return foundAddress, nil
+}
+
+// handleValidatorAdded is the registry handler that counts nonces for the configured event.
+func (nc *NonceCounter) handleValidatorAdded(event *ValidatorAddedEvent, vLog types.Log) {
+	if nc.verifyShares {
+		nc.verifyShareSignature(*event, vLog)
+	}
+
+	nonce, _ := nc.NextNonce(event.Owner)
+	if incremented := nc.incrementNonce(*event); incremented {
+		nc.nonceChanged.Store(true)
+		nc.Logger().Info("nonce incremented", "owner", event.Owner.Hex(), "nonce", nonce, "next_nonce", nonce+1,
+			"block", vLog.BlockNumber, "tx_hash", vLog.TxHash.Hex(), "log_index", vLog.Index)
+		nc.reconcileReservations(event.Owner)
+		nc.recordIncrement(event.Owner, nonce, vLog)
+		if nc.attributeSubmissions {
+			nc.recordSubmission(event.Owner, nonce, vLog)
+		}
+	}
+}
+
+// verifyShareSignature checks that the shares of a tracked owner's registration were signed over the nonce the counter
+// holds for the owner before counting it, logging and counting mismatches.
+func (nc *NonceCounter) verifyShareSignature(vae ValidatorAddedEvent, vLog types.Log) {
+	nc.mu.Lock()
+	nonce, tracked := nc.addressToNonce[vae.Owner.Hex()]
+	nc.mu.Unlock()
+	if !tracked {
+		return
+	}
+
+	keyShares, err := ParseShares(vae.Shares, len(vae.OperatorIds))
+	if err == nil {
+		err = VerifyShareSignature(keyShares.Signature, vae.PublicKey, vae.Owner, nonce)
+	}
+	if err != nil {
+		nc.shareMismatches.Add(1)
+		nc.Logger().Warn("shares do not match the counted nonce", "public_key", hexutil.Encode(vae.PublicKey),
+			"owner", vae.Owner.Hex(), "nonce", nonce, "tx_hash", vLog.TxHash.Hex(), "log_index", vLog.Index, "error", err)
+	}
+}
+
+// ShareMismatches returns the number of tracked owner registrations whose shares signature did not match the counted
+// nonce since the counter was created. It stays at 0 unless VerifyShares is enabled.
+func (nc *NonceCounter) ShareMismatches() uint64 {
+	return nc.shareMismatches.Load()
+}
+
+// DecodeErrors returns the number of logs of the configured event that failed to decode since the counter was created.
+func (nc *NonceCounter) DecodeErrors() uint64 {
+	return nc.decodeErrors.Load()
 }
 
 // prepareQuery constructs and returns an Ethereum FilterQuery to fetch logs within a specific block range and address list.
-func (nc *NonceCounter) prepareQuery(header *types.Header, currentBlock *big.Int) ethereum.FilterQuery {
+func (nc *NonceCounter) prepareQuery(ctx context.Context, header *types.Header, currentBlock *big.Int) ethereum.FilterQuery {
+	_, span := nc.startSpan(ctx, "NonceCounter.prepareQuery", trace.WithAttributes(headBlockKey.Int64(header.Number.Int64())))
+	defer span.End()
+
 	latestBlock := header.Number
 
 	endBlock := new(big.Int).Add(currentBlock, big.NewInt(nc.blockBatchSize))
@@ -199,6 +710,7 @@ func (nc *NonceCounter) prepareQuery(header *types.Header, currentBlock *big.Int
 		currentBlock = endBlock
 	}
 
+	span.SetAttributes(blockRangeAttributes(currentBlock.Uint64(), endBlock.Uint64())...)
 	return ethereum.FilterQuery{
 		FromBlock: currentBlock,
 		ToBlock:   endBlock,
@@ -208,6 +720,32 @@ func (nc *NonceCounter) prepareQuery(header *types.Header, currentBlock *big.Int
 	}
 }
 
+// NextNonce returns the nonce the next registration of owner has to be signed with, and whether owner is tracked.
+func (nc *NonceCounter) NextNonce(owner common.Address) (uint64, bool) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	nonce, ok := nc.addressToNonce[owner.Hex()]
+	return nonce, ok
+}
+
+// Nonces returns a copy of the nonces of every tracked owner.
+func (nc *NonceCounter) Nonces() map[common.Address]uint64 {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	nonces := make(map[common.Address]uint64, len(nc.addressToNonce))
+	for address, nonce := range nc.addressToNonce {
+		nonces[common.HexToAddress(address)] = nonce
+	}
+	return nonces
+}
+
+// normalizeOwner returns the checksummed form of a hex address.
+func normalizeOwner(address string) string {
+	return common.HexToAddress(address).Hex()
+}
+
 // incrementNonce increments the nonce for a specific address if it exists and returns whether a change was made.
 func (nc *NonceCounter) incrementNonce(vae ValidatorAddedEvent) bool {
 	if contains := slices.Contains(nc.addresses, vae.Owner.Hex()); !contains {
@@ -226,10 +764,17 @@ func (nc *NonceCounter) printNonces() {
 	nc.mu.Lock()
 	defer nc.mu.Unlock()
 
-	fmt.Println("-----------------------------------------")
-	fmt.Println("Nonce for address modified, current state:")
-	for address, nonce := range nc.addressToNonce {
-		fmt.Printf("Address: %s, Nonce: %d\n", address, nonce)
+	// Owners are printed in configuration order so the output of identical runs is identical
+	out := nc.out()
+	fmt.Fprintln(out, "-----------------------------------------")
+	fmt.Fprintln(out, "Nonce for address modified, current state:")
+	for _, address := range nc.addresses {
+		nonce := nc.addressToNonce[address]
+		if pending := nc.pending[address]; pending > 0 {
+			fmt.Fprintf(out, "Address: %s, Nonce: %d, Pending Nonce: %d\n", address, nonce, nonce+pending)
+			continue
+		}
+		fmt.Fprintf(out, "Address: %s, Nonce: %d\n", address, nonce)
 	}
-	fmt.Println("-----------------------------------------")
+	fmt.Fprintln(out, "-----------------------------------------")
 }
@@ -1,13 +1,75 @@
 package noncecounter
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
 	"math/big"
+	"net/http/httptest"
+	"slices"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// fakeEthService serves the eth_getBlockByNumber and eth_getLogs calls of a scan over a fixed chain head.
+type fakeEthService struct {
+	head uint64
+	logs []types.Log
+
+	mu sync.Mutex
+	// ranges are the block ranges logs were fetched for, in order
+	ranges [][2]uint64
+}
+
+func (s *fakeEthService) GetBlockByNumber(_ context.Context, _ rpc.BlockNumber, _ bool) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(s.head), Difficulty: common.Big0}, nil
+}
+
+func (s *fakeEthService) GetLogs(_ context.Context, criteria map[string]any) ([]types.Log, error) {
+	from, err := hexutil.DecodeUint64(criteria["fromBlock"].(string))
+	if err != nil {
+		return nil, err
+	}
+	to, err := hexutil.DecodeUint64(criteria["toBlock"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ranges = append(s.ranges, [2]uint64{from, to})
+	logs := []types.Log{}
+	for _, vLog := range s.logs {
+		if vLog.BlockNumber >= from && vLog.BlockNumber <= to {
+			logs = append(logs, vLog)
+		}
+	}
+	return logs, nil
+}
+
+// newFakeRPC serves service as the eth namespace of a JSON-RPC server and returns its URL.
+func newFakeRPC(t *testing.T, service *fakeEthService) string {
+	t.Helper()
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", service); err != nil {
+		t.Fatalf("RegisterName() error = %v", err)
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+	return httpServer.URL
+}
+
 func TestPrepareQuery(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -53,7 +115,7 @@ func TestPrepareQuery(t *testing.T) {
 			header := &types.Header{Number: tt.headerNumber}
 
 			// Call prepareQuery
-			query := nc.prepareQuery(header, tt.currentBlock)
+			query := nc.prepareQuery(context.Background(), header, tt.currentBlock)
 
 			// Validate results
 			if query.FromBlock.Cmp(tt.expectedFrom) != 0 {
@@ -146,9 +208,9 @@ func TestConfigValidate(t *testing.T) {
 			config: Config{
 				Concurrency:     10,
 				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
-				ContractABI:     `[]`,
+				ContractABI:     SSVNetworkMetaData.ABI,
 				StartBlock:      0,
-				EventName:       "Transfer",
+				EventName:       "ValidatorAdded",
 				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
 				BlockBatchSize:  100,
 			},
@@ -159,9 +221,9 @@ func TestConfigValidate(t *testing.T) {
 			config: Config{
 				Concurrency:     0,
 				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
-				ContractABI:     `[]`,
+				ContractABI:     SSVNetworkMetaData.ABI,
 				StartBlock:      0,
-				EventName:       "Transfer",
+				EventName:       "ValidatorAdded",
 				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
 				BlockBatchSize:  100,
 			},
@@ -172,9 +234,9 @@ func TestConfigValidate(t *testing.T) {
 			config: Config{
 				Concurrency:     10,
 				ContractAddress: "",
-				ContractABI:     `[]`,
+				ContractABI:     SSVNetworkMetaData.ABI,
 				StartBlock:      0,
-				EventName:       "Transfer",
+				EventName:       "ValidatorAdded",
 				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
 				BlockBatchSize:  100,
 			},
@@ -187,7 +249,7 @@ func TestConfigValidate(t *testing.T) {
 				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
 				ContractABI:     "",
 				StartBlock:      0,
-				EventName:       "Transfer",
+				EventName:       "ValidatorAdded",
 				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
 				BlockBatchSize:  100,
 			},
@@ -198,9 +260,9 @@ func TestConfigValidate(t *testing.T) {
 			config: Config{
 				Concurrency:     10,
 				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
-				ContractABI:     `[]`,
+				ContractABI:     SSVNetworkMetaData.ABI,
 				StartBlock:      -1,
-				EventName:       "Transfer",
+				EventName:       "ValidatorAdded",
 				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
 				BlockBatchSize:  100,
 			},
@@ -211,7 +273,7 @@ func TestConfigValidate(t *testing.T) {
 			config: Config{
 				Concurrency:     10,
 				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
-				ContractABI:     `[]`,
+				ContractABI:     SSVNetworkMetaData.ABI,
 				StartBlock:      0,
 				EventName:       "",
 				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
@@ -224,22 +286,127 @@ func TestConfigValidate(t *testing.T) {
 			config: Config{
 				Concurrency:     10,
 				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
-				ContractABI:     `[]`,
+				ContractABI:     SSVNetworkMetaData.ABI,
 				StartBlock:      0,
-				EventName:       "Transfer",
+				EventName:       "ValidatorAdded",
 				Addresses:       []string{},
 				BlockBatchSize:  100,
 			},
 			wantErr: true,
 		},
+		{
+			name: "event not in ABI",
+			config: Config{
+				Concurrency:     10,
+				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
+				ContractABI:     `[{"anonymous":false,"inputs":[{"indexed":true,"name":"owner","type":"address"}],"name":"Transfer","type":"event"}]`,
+				StartBlock:      0,
+				EventName:       "ValidatorAdded",
+				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
+				BlockBatchSize:  100,
+			},
+			wantErr: true,
+		},
+		{
+			name: "event without owner",
+			config: Config{
+				Concurrency:     10,
+				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
+				ContractABI:     `[{"anonymous":false,"inputs":[{"indexed":true,"name":"operatorId","type":"uint64"}],"name":"ValidatorAdded","type":"event"}]`,
+				StartBlock:      0,
+				EventName:       "ValidatorAdded",
+				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
+				BlockBatchSize:  100,
+			},
+			wantErr: true,
+		},
+		{
+			name: "other owner event",
+			config: Config{
+				Concurrency:     10,
+				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
+				ContractABI:     SSVNetworkMetaData.ABI,
+				StartBlock:      0,
+				EventName:       "ValidatorRemoved",
+				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
+				BlockBatchSize:  100,
+			},
+			wantErr: true,
+		},
+		{
+			name: "owner not indexed",
+			config: Config{
+				Concurrency:     10,
+				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
+				ContractABI:     `[{"anonymous":false,"inputs":[{"indexed":false,"name":"owner","type":"address"}],"name":"ValidatorAdded","type":"event"}]`,
+				StartBlock:      0,
+				EventName:       "ValidatorAdded",
+				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
+				BlockBatchSize:  100,
+			},
+			wantErr: true,
+		},
+		{
+			name: "contract ABI path",
+			config: Config{
+				Concurrency:     10,
+				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
+				ContractABIPath: "../cmd/ssv_network.abi.json",
+				StartBlock:      0,
+				EventName:       "ValidatorAdded",
+				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
+				BlockBatchSize:  100,
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing contract ABI path",
+			config: Config{
+				Concurrency:     10,
+				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
+				ContractABIPath: "does_not_exist.json",
+				StartBlock:      0,
+				EventName:       "ValidatorAdded",
+				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
+				BlockBatchSize:  100,
+			},
+			wantErr: true,
+		},
+		{
+			name: "both contract ABI and path",
+			config: Config{
+				Concurrency:     10,
+				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
+				ContractABI:     SSVNetworkMetaData.ABI,
+				ContractABIPath: "../cmd/ssv_network.abi.json",
+				StartBlock:      0,
+				EventName:       "ValidatorAdded",
+				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
+				BlockBatchSize:  100,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid address",
+			config: Config{
+				Concurrency:     10,
+				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
+				ContractABI:     SSVNetworkMetaData.ABI,
+				StartBlock:      0,
+				EventName:       "ValidatorAdded",
+				Addresses:       []string{"0xabcdef"},
+				BlockBatchSize:  100,
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid block batch size",
 			config: Config{
 				Concurrency:     10,
 				ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
-				ContractABI:     `[]`,
+				ContractABI:     SSVNetworkMetaData.ABI,
 				StartBlock:      0,
-				EventName:       "Transfer",
+				EventName:       "ValidatorAdded",
 				Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12"},
 				BlockBatchSize:  0,
 			},
@@ -256,3 +423,212 @@ func TestConfigValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewNonceCounterNormalizesAddresses(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+
+	nc, err := NewNonceCounter(Config{
+		Concurrency:     10,
+		ContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
+		ContractABI:     SSVNetworkMetaData.ABI,
+		EventName:       "ValidatorAdded",
+		Addresses:       []string{"0xabcdef1234567890abcdef1234567890abcdef12", "0xABCDEF1234567890ABCDEF1234567890ABCDEF12"},
+		BlockBatchSize:  100,
+	})
+	if err != nil {
+		t.Fatalf("NewNonceCounter() error = %v", err)
+	}
+
+	if got := nc.incrementNonce(ValidatorAddedEvent{Owner: owner}); !got {
+		t.Errorf("incrementNonce() = false for a lowercase configured address")
+	}
+	if nonce, ok := nc.NextNonce(owner); !ok || nonce != 1 {
+		t.Errorf("NextNonce() = %d, %v, want 1, true", nonce, ok)
+	}
+	if _, ok := nc.NextNonce(common.Address{}); ok {
+		t.Errorf("NextNonce() tracks an unconfigured address")
+	}
+	if nonces := nc.Nonces(); len(nonces) != 1 || nonces[owner] != 1 {
+		t.Errorf("Nonces() = %v, want only %s at 1", nonces, owner.Hex())
+	}
+}
+
+func TestFindNoncesDecodeErrors(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	valid := newValidatorAddedLog(t, contractAbi, owner)
+	malformed := types.Log{Topics: valid.Topics, Data: []byte{0xde, 0xad}}
+	otherEvent := types.Log{Topics: []common.Hash{common.HexToHash("0x01")}}
+
+	tests := []struct {
+		name             string
+		strictDecoding   bool
+		logs             []types.Log
+		wantFound        bool
+		wantErr          bool
+		wantDecodeErrors uint64
+	}{
+		{
+			name:      "other events are skipped",
+			logs:      []types.Log{valid, otherEvent},
+			wantFound: true,
+		},
+		{
+			name:             "decode errors are counted",
+			logs:             []types.Log{valid, malformed, malformed},
+			wantFound:        true,
+			wantDecodeErrors: 2,
+		},
+		{
+			name:             "decode errors are fatal in strict mode",
+			strictDecoding:   true,
+			logs:             []types.Log{malformed},
+			wantErr:          true,
+			wantDecodeErrors: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nc := &NonceCounter{
+				eventName:      "ValidatorAdded",
+				contractAbi:    contractAbi,
+				addresses:      []string{owner.Hex()},
+				addressToNonce: map[string]uint64{owner.Hex(): 0},
+				concurrency:    2,
+				strictDecoding: tt.strictDecoding,
+				registry:       NewRegistry(contractAbi),
+			}
+			if err := Handle(nc.registry, nc.eventName, nc.handleValidatorAdded); err != nil {
+				t.Fatalf("Handle() error = %v", err)
+			}
+
+			found, err := nc.FindNonces(context.Background(), tt.logs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindNonces() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if found != tt.wantFound {
+				t.Errorf("FindNonces() = %v, want %v", found, tt.wantFound)
+			}
+			if got := nc.DecodeErrors(); got != tt.wantDecodeErrors {
+				t.Errorf("DecodeErrors() = %d, want %d", got, tt.wantDecodeErrors)
+			}
+		})
+	}
+}
+
+func TestStructuredLogging(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	var logs bytes.Buffer
+	config := newTestConfig(&bytes.Buffer{}, owner)
+	config.Logger = slog.New(slog.NewJSONHandler(&logs, nil))
+	nc := newTestNonceCounter(t, config)
+
+	valid := newValidatorAddedLog(t, contractAbi, owner)
+	valid.BlockNumber = 10
+	valid.TxHash = common.BigToHash(common.Big3)
+	malformed := types.Log{Topics: valid.Topics, BlockNumber: 10, TxHash: valid.TxHash, Index: 1}
+	if err := nc.processBatch(context.Background(), Batch{FromBlock: 5, ToBlock: 12, Logs: 2}, []types.Log{valid, malformed}, nil); err != nil {
+		t.Fatalf("processBatch() error = %v", err)
+	}
+
+	var records []map[string]any
+	decoder := json.NewDecoder(&logs)
+	for decoder.More() {
+		var record map[string]any
+		if err := decoder.Decode(&record); err != nil {
+			t.Fatalf("invalid log record: %v", err)
+		}
+		records = append(records, record)
+	}
+
+	want := []map[string]any{
+		{"level": "ERROR", "msg": "failed to decode log", "event": "ValidatorAdded", "block": 10.0, "tx_hash": valid.TxHash.Hex(), "log_index": 1.0},
+		{"level": "INFO", "msg": "nonce incremented", "owner": owner.Hex(), "nonce": 0.0, "next_nonce": 1.0, "tx_hash": valid.TxHash.Hex()},
+		{"level": "INFO", "msg": "processed block range", "from_block": 5.0, "to_block": 12.0, "log_count": 2.0, "increments": 1.0},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("logged %d records, want %d: %v", len(records), len(want), records)
+	}
+	for i, fields := range want {
+		for key, value := range fields {
+			if records[i][key] != value {
+				t.Errorf("record %d field %s = %v, want %v", i, key, records[i][key], value)
+			}
+		}
+	}
+}
+
+func TestSyncDoesNotRescanHead(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	vLog := newValidatorAddedLog(t, contractAbi, owner)
+	vLog.BlockNumber = 15
+	service := &fakeEthService{head: 15, logs: []types.Log{vLog}}
+	rpcURL := newFakeRPC(t, service)
+	nc := newReplayNonceCounter(t, &bytes.Buffer{}, owner)
+
+	// Resuming right after the head finds nothing to fetch, prepareQuery would otherwise clamp the range back to it
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, from := range []uint64{10, 16} {
+		last, err := nc.Sync(ctx, from, rpcURL)
+		if err != nil {
+			t.Fatalf("Sync(%d) error = %v", from, err)
+		}
+		if last != 15 {
+			t.Errorf("Sync(%d) = %d, want 15", from, last)
+		}
+	}
+	if nonce, _ := nc.NextNonce(owner); nonce != 1 {
+		t.Errorf("NextNonce() = %d, want 1", nonce)
+	}
+	if want := [][2]uint64{{10, 15}}; !slices.Equal(service.ranges, want) {
+		t.Errorf("fetched ranges = %v, want %v", service.ranges, want)
+	}
+}
+
+func TestSyncAtHead(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	rpcURL := newFakeRPC(t, &fakeEthService{head: 15})
+
+	tests := []struct {
+		name       string
+		untilBlock uint64
+		wantLast   uint64
+		wantSynced bool
+		// wantAtHead is the AtHead flag of the batches, 10-13 and 14 on
+		wantAtHead []bool
+	}{
+		{name: "chain head", wantLast: 15, wantSynced: true, wantAtHead: []bool{false, true}},
+		{name: "until block before the head", untilBlock: 12, wantLast: 12, wantAtHead: []bool{false}},
+		{name: "until block past the head", untilBlock: 20, wantLast: 15, wantSynced: true, wantAtHead: []bool{false, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := newTestConfig(&bytes.Buffer{}, owner)
+			config.UntilBlock = tt.untilBlock
+			config.BlockBatchSize = 3
+			nc := newTestNonceCounter(t, config)
+			var atHead []bool
+			nc.OnBatch(func(batch Batch) {
+				atHead = append(atHead, batch.AtHead)
+			})
+			last, err := nc.Sync(context.Background(), 10, rpcURL)
+			if err != nil {
+				t.Fatalf("Sync() error = %v", err)
+			}
+			if last != tt.wantLast {
+				t.Errorf("Sync() = %d, want %d", last, tt.wantLast)
+			}
+			if got := nc.Synced(); got != tt.wantSynced {
+				t.Errorf("Synced() = %v, want %v", got, tt.wantSynced)
+			}
+			if !slices.Equal(atHead, tt.wantAtHead) {
+				t.Errorf("batches AtHead = %v, want %v", atHead, tt.wantAtHead)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+// Package noncecounterpb holds the protobuf messages and gRPC stubs of the NonceCounter service, generated from
+// proto/noncecounter/v1/nonce_counter.proto.
+package noncecounterpb
+
+//go:generate protoc -I ../../proto --go_out=. --go_opt=module=github.com/rem1niscence/ssv-nounce-counter/nonce_counter/noncecounterpb --go-grpc_out=. --go-grpc_opt=module=github.com/rem1niscence/ssv-nounce-counter/nonce_counter/noncecounterpb noncecounter/v1/nonce_counter.proto
@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: noncecounter/v1/nonce_counter.proto
+
+package noncecounterpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NonceCounter_GetNonce_FullMethodName    = "/noncecounter.v1.NonceCounter/GetNonce"
+	NonceCounter_ListNonces_FullMethodName  = "/noncecounter.v1.NonceCounter/ListNonces"
+	NonceCounter_GetStatus_FullMethodName   = "/noncecounter.v1.NonceCounter/GetStatus"
+	NonceCounter_WatchNonces_FullMethodName = "/noncecounter.v1.NonceCounter/WatchNonces"
+)
+
+// NonceCounterClient is the client API for NonceCounter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NonceCounter serves the SSV registration nonces of the owners tracked by a running counter.
+type NonceCounterClient interface {
+	// GetNonce returns the next nonce of a tracked owner. It fails with NOT_FOUND for owners the counter does not track.
+	GetNonce(ctx context.Context, in *GetNonceRequest, opts ...grpc.CallOption) (*GetNonceResponse, error)
+	// ListNonces returns the next nonce of every tracked owner, ordered by owner address.
+	ListNonces(ctx context.Context, in *ListNoncesRequest, opts ...grpc.CallOption) (*ListNoncesResponse, error)
+	// GetStatus returns the scan progress of the counter.
+	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error)
+	// WatchNonces streams the current nonce of the requested owners, then every nonce they consume as the counter
+	// processes new blocks. The stream fails with RESOURCE_EXHAUSTED when the client falls too far behind.
+	WatchNonces(ctx context.Context, in *WatchNoncesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NonceUpdate], error)
+}
+
+type nonceCounterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNonceCounterClient(cc grpc.ClientConnInterface) NonceCounterClient {
+	return &nonceCounterClient{cc}
+}
+
+func (c *nonceCounterClient) GetNonce(ctx context.Context, in *GetNonceRequest, opts ...grpc.CallOption) (*GetNonceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNonceResponse)
+	err := c.cc.Invoke(ctx, NonceCounter_GetNonce_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nonceCounterClient) ListNonces(ctx context.Context, in *ListNoncesRequest, opts ...grpc.CallOption) (*ListNoncesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNoncesResponse)
+	err := c.cc.Invoke(ctx, NonceCounter_ListNonces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nonceCounterClient) GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatusResponse)
+	err := c.cc.Invoke(ctx, NonceCounter_GetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nonceCounterClient) WatchNonces(ctx context.Context, in *WatchNoncesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NonceUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NonceCounter_ServiceDesc.Streams[0], NonceCounter_WatchNonces_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchNoncesRequest, NonceUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NonceCounter_WatchNoncesClient = grpc.ServerStreamingClient[NonceUpdate]
+
+// NonceCounterServer is the server API for NonceCounter service.
+// All implementations must embed UnimplementedNonceCounterServer
+// for forward compatibility.
+//
+// NonceCounter serves the SSV registration nonces of the owners tracked by a running counter.
+type NonceCounterServer interface {
+	// GetNonce returns the next nonce of a tracked owner. It fails with NOT_FOUND for owners the counter does not track.
+	GetNonce(context.Context, *GetNonceRequest) (*GetNonceResponse, error)
+	// ListNonces returns the next nonce of every tracked owner, ordered by owner address.
+	ListNonces(context.Context, *ListNoncesRequest) (*ListNoncesResponse, error)
+	// GetStatus returns the scan progress of the counter.
+	GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error)
+	// WatchNonces streams the current nonce of the requested owners, then every nonce they consume as the counter
+	// processes new blocks. The stream fails with RESOURCE_EXHAUSTED when the client falls too far behind.
+	WatchNonces(*WatchNoncesRequest, grpc.ServerStreamingServer[NonceUpdate]) error
+	mustEmbedUnimplementedNonceCounterServer()
+}
+
+// UnimplementedNonceCounterServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNonceCounterServer struct{}
+
+func (UnimplementedNonceCounterServer) GetNonce(context.Context, *GetNonceRequest) (*GetNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNonce not implemented")
+}
+func (UnimplementedNonceCounterServer) ListNonces(context.Context, *ListNoncesRequest) (*ListNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNonces not implemented")
+}
+func (UnimplementedNonceCounterServer) GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedNonceCounterServer) WatchNonces(*WatchNoncesRequest, grpc.ServerStreamingServer[NonceUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNonces not implemented")
+}
+func (UnimplementedNonceCounterServer) mustEmbedUnimplementedNonceCounterServer() {}
+func (UnimplementedNonceCounterServer) testEmbeddedByValue()                      {}
+
+// UnsafeNonceCounterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NonceCounterServer will
+// result in compilation errors.
+type UnsafeNonceCounterServer interface {
+	mustEmbedUnimplementedNonceCounterServer()
+}
+
+func RegisterNonceCounterServer(s grpc.ServiceRegistrar, srv NonceCounterServer) {
+	// If the following call pancis, it indicates UnimplementedNonceCounterServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NonceCounter_ServiceDesc, srv)
+}
+
+func _NonceCounter_GetNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NonceCounterServer).GetNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NonceCounter_GetNonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NonceCounterServer).GetNonce(ctx, req.(*GetNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NonceCounter_ListNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NonceCounterServer).ListNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NonceCounter_ListNonces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NonceCounterServer).ListNonces(ctx, req.(*ListNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NonceCounter_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NonceCounterServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NonceCounter_GetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NonceCounterServer).GetStatus(ctx, req.(*GetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NonceCounter_WatchNonces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNoncesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NonceCounterServer).WatchNonces(m, &grpc.GenericServerStream[WatchNoncesRequest, NonceUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NonceCounter_WatchNoncesServer = grpc.ServerStreamingServer[NonceUpdate]
+
+// NonceCounter_ServiceDesc is the grpc.ServiceDesc for NonceCounter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NonceCounter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "noncecounter.v1.NonceCounter",
+	HandlerType: (*NonceCounterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNonce",
+			Handler:    _NonceCounter_GetNonce_Handler,
+		},
+		{
+			MethodName: "ListNonces",
+			Handler:    _NonceCounter_ListNonces_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _NonceCounter_GetStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchNonces",
+			Handler:       _NonceCounter_WatchNonces_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "noncecounter/v1/nonce_counter.proto",
+}
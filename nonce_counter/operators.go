@@ -0,0 +1,240 @@
+package noncecounter
+
+import (
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Operator is the locally indexed state of an SSV operator.
+type Operator struct {
+	ID        uint64
+	Owner     common.Address
+	PublicKey []byte
+	// Fee is the fee the operator currently charges per block and validator.
+	Fee *big.Int
+	// PendingFee is a declared fee change waiting to be executed, nil if there is none.
+	PendingFee *big.Int
+	// Index is the fee the operator accrued per validator from its registration until IndexBlock, like the contract
+	// snapshot index but not shrunk. It is nil if the operator was added before the scan started.
+	Index      *big.Int
+	IndexBlock uint64
+	Private    bool
+	// Whitelisted are the addresses allowed to register validators with the operator when it is private.
+	Whitelisted          []common.Address
+	WhitelistingContract common.Address
+	Removed              bool
+	// AddedBlock is the block of the OperatorAdded event, 0 if the operator was added before the scan started.
+	AddedBlock uint64
+}
+
+// OperatorRegistry indexes the operators of the network from their registration, fee, privacy and whitelist events.
+type OperatorRegistry struct {
+	mu        sync.RWMutex
+	operators map[uint64]*Operator
+}
+
+// NewOperatorRegistry creates an empty OperatorRegistry, which has to be registered on a Registry to receive events.
+func NewOperatorRegistry() *OperatorRegistry {
+	return &OperatorRegistry{
+		operators: map[uint64]*Operator{},
+	}
+}
+
+// Register subscribes the operator registry to every operator event.
+func (or *OperatorRegistry) Register(r *Registry) error {
+	if err := Handle(r, "OperatorAdded", func(e *SSVNetworkOperatorAdded, vLog types.Log) {
+		or.update(e.OperatorId, func(op *Operator) {
+			op.Owner = e.Owner
+			op.PublicKey = slices.Clone(e.PublicKey)
+			op.Fee = new(big.Int).Set(e.Fee)
+			op.Index = new(big.Int)
+			op.IndexBlock = vLog.BlockNumber
+			op.AddedBlock = vLog.BlockNumber
+		})
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "OperatorRemoved", func(e *SSVNetworkOperatorRemoved, vLog types.Log) {
+		or.update(e.OperatorId, func(op *Operator) {
+			// The contract stops charging for removed operators
+			op.accrue(vLog.BlockNumber)
+			op.Removed = true
+			op.Fee = new(big.Int)
+			op.PendingFee = nil
+		})
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "OperatorFeeDeclared", func(e *SSVNetworkOperatorFeeDeclared, _ types.Log) {
+		or.update(e.OperatorId, func(op *Operator) {
+			op.Owner = e.Owner
+			op.PendingFee = new(big.Int).Set(e.Fee)
+		})
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "OperatorFeeExecuted", func(e *SSVNetworkOperatorFeeExecuted, vLog types.Log) {
+		or.update(e.OperatorId, func(op *Operator) {
+			op.accrue(vLog.BlockNumber)
+			op.Owner = e.Owner
+			op.Fee = new(big.Int).Set(e.Fee)
+			op.PendingFee = nil
+		})
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "OperatorFeeDeclarationCancelled", func(e *SSVNetworkOperatorFeeDeclarationCancelled, _ types.Log) {
+		or.update(e.OperatorId, func(op *Operator) {
+			op.Owner = e.Owner
+			op.PendingFee = nil
+		})
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "OperatorPrivacyStatusUpdated", func(e *SSVNetworkOperatorPrivacyStatusUpdated, _ types.Log) {
+		for _, id := range e.OperatorIds {
+			or.update(id, func(op *Operator) {
+				op.Private = e.ToPrivate
+			})
+		}
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "OperatorMultipleWhitelistUpdated", func(e *SSVNetworkOperatorMultipleWhitelistUpdated, _ types.Log) {
+		for _, id := range e.OperatorIds {
+			or.update(id, func(op *Operator) {
+				for _, address := range e.WhitelistAddresses {
+					if !slices.Contains(op.Whitelisted, address) {
+						op.Whitelisted = append(op.Whitelisted, address)
+					}
+				}
+			})
+		}
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "OperatorMultipleWhitelistRemoved", func(e *SSVNetworkOperatorMultipleWhitelistRemoved, _ types.Log) {
+		for _, id := range e.OperatorIds {
+			or.update(id, func(op *Operator) {
+				op.Whitelisted = slices.DeleteFunc(op.Whitelisted, func(address common.Address) bool {
+					return slices.Contains(e.WhitelistAddresses, address)
+				})
+			})
+		}
+	}); err != nil {
+		return err
+	}
+	return Handle(r, "OperatorWhitelistingContractUpdated", func(e *SSVNetworkOperatorWhitelistingContractUpdated, _ types.Log) {
+		for _, id := range e.OperatorIds {
+			or.update(id, func(op *Operator) {
+				op.WhitelistingContract = e.WhitelistingContract
+			})
+		}
+	})
+}
+
+// Operator returns the indexed state of the operator with the given ID, if any event for it was seen.
+func (or *OperatorRegistry) Operator(id uint64) (Operator, bool) {
+	or.mu.RLock()
+	defer or.mu.RUnlock()
+
+	op, ok := or.operators[id]
+	if !ok {
+		return Operator{}, false
+	}
+	return op.clone(), true
+}
+
+// Operators returns the indexed state of every known operator with one of the given IDs, in the given order.
+func (or *OperatorRegistry) Operators(ids []uint64) []Operator {
+	or.mu.RLock()
+	defer or.mu.RUnlock()
+
+	operators := make([]Operator, 0, len(ids))
+	for _, id := range ids {
+		if op, ok := or.operators[id]; ok {
+			operators = append(operators, op.clone())
+		}
+	}
+	return operators
+}
+
+// OperatorIDs returns the sorted IDs of the operators running validators of the given clusters.
+func OperatorIDs(clusters []Cluster) []uint64 {
+	var ids []uint64
+	for _, cluster := range clusters {
+		if cluster.ValidatorCount == 0 {
+			continue
+		}
+		for _, id := range cluster.OperatorIds {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fee returns the current fee of an operator and its fee index at block, either is nil when it is not known.
+func (or *OperatorRegistry) fee(id uint64, block uint64) (fee *big.Int, index *big.Int) {
+	or.mu.RLock()
+	defer or.mu.RUnlock()
+
+	op, ok := or.operators[id]
+	if !ok || op.Fee == nil {
+		return nil, nil
+	}
+	if op.Index != nil && block >= op.IndexBlock {
+		index = accrued(op.Index, op.Fee, block-op.IndexBlock)
+	}
+	return op.Fee, index
+}
+
+// update applies fn to the operator with the given ID, creating it if it was added before the scan started.
+func (or *OperatorRegistry) update(id uint64, fn func(op *Operator)) {
+	or.mu.Lock()
+	defer or.mu.Unlock()
+
+	op, ok := or.operators[id]
+	if !ok {
+		op = &Operator{ID: id}
+		or.operators[id] = op
+	}
+	fn(op)
+}
+
+// clone returns a deep copy of op so the state handed out is not affected by later events.
+func (op *Operator) clone() Operator {
+	c := *op
+	c.PublicKey = slices.Clone(op.PublicKey)
+	c.Whitelisted = slices.Clone(op.Whitelisted)
+	if op.Fee != nil {
+		c.Fee = new(big.Int).Set(op.Fee)
+	}
+	if op.PendingFee != nil {
+		c.PendingFee = new(big.Int).Set(op.PendingFee)
+	}
+	if op.Index != nil {
+		c.Index = new(big.Int).Set(op.Index)
+	}
+	return c
+}
+
+// accrue moves the fee index of op to block, the contract does it before every fee change.
+func (op *Operator) accrue(block uint64) {
+	if op.Index == nil || op.Fee == nil || block <= op.IndexBlock {
+		return
+	}
+	op.Index = accrued(op.Index, op.Fee, block-op.IndexBlock)
+	op.IndexBlock = block
+}
+
+// accrued returns index increased by fee for every one of blocks.
+func accrued(index, fee *big.Int, blocks uint64) *big.Int {
+	return new(big.Int).Add(index, new(big.Int).Mul(fee, new(big.Int).SetUint64(blocks)))
+}
@@ -0,0 +1,111 @@
+package noncecounter
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestOperatorRegistry(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	whitelisted := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+	operatorTopic := func(id uint64) common.Hash {
+		return common.BigToHash(new(big.Int).SetUint64(id))
+	}
+	ownerTopic := common.BytesToHash(owner.Bytes())
+
+	logs := []types.Log{
+		newEventLog(t, contractAbi, "OperatorAdded", []common.Hash{operatorTopic(1), ownerTopic}, []byte{0xaa}, big.NewInt(100)),
+		newEventLog(t, contractAbi, "OperatorAdded", []common.Hash{operatorTopic(2), ownerTopic}, []byte{0xbb}, big.NewInt(200)),
+		newEventLog(t, contractAbi, "OperatorAdded", []common.Hash{operatorTopic(3), ownerTopic}, []byte{0xcc}, big.NewInt(300)),
+		newEventLog(t, contractAbi, "OperatorFeeDeclared", []common.Hash{ownerTopic, operatorTopic(1)}, big.NewInt(10), big.NewInt(150)),
+		newEventLog(t, contractAbi, "OperatorFeeExecuted", []common.Hash{ownerTopic, operatorTopic(1)}, big.NewInt(20), big.NewInt(150)),
+		newEventLog(t, contractAbi, "OperatorFeeDeclared", []common.Hash{ownerTopic, operatorTopic(2)}, big.NewInt(10), big.NewInt(250)),
+		newEventLog(t, contractAbi, "OperatorPrivacyStatusUpdated", nil, []uint64{1, 2}, true),
+		newEventLog(t, contractAbi, "OperatorMultipleWhitelistUpdated", nil, []uint64{1, 2}, []common.Address{whitelisted, owner}),
+		newEventLog(t, contractAbi, "OperatorMultipleWhitelistRemoved", nil, []uint64{2}, []common.Address{owner}),
+		newEventLog(t, contractAbi, "OperatorWhitelistingContractUpdated", nil, []uint64{2}, whitelisted),
+		newEventLog(t, contractAbi, "OperatorRemoved", []common.Hash{operatorTopic(3)}),
+		newEventLog(t, contractAbi, "OperatorFeeExecuted", []common.Hash{ownerTopic, operatorTopic(4)}, big.NewInt(20), big.NewInt(400)),
+	}
+	for i := range logs {
+		logs[i].BlockNumber = uint64(i * 10)
+	}
+
+	operators := NewOperatorRegistry()
+	nc := &NonceCounter{concurrency: 2, registry: NewRegistry(contractAbi)}
+	if err := operators.Register(nc.registry); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if _, err := nc.FindNonces(context.Background(), logs); err != nil {
+		t.Fatalf("FindNonces() error = %v", err)
+	}
+
+	tests := []struct {
+		id               uint64
+		wantFee          int64
+		wantPendingFee   int64
+		wantPrivate      bool
+		wantWhitelisted  []common.Address
+		wantWhitelisting common.Address
+		wantRemoved      bool
+		// wantIndex is the fee index at block 100, -1 when it is unknown.
+		wantIndex int64
+	}{
+		{id: 1, wantFee: 150, wantPrivate: true, wantWhitelisted: []common.Address{whitelisted, owner}, wantIndex: 4000 + 60*150},
+		{id: 2, wantFee: 200, wantPendingFee: 250, wantPrivate: true, wantWhitelisted: []common.Address{whitelisted}, wantWhitelisting: whitelisted, wantIndex: 90 * 200},
+		{id: 3, wantFee: 0, wantRemoved: true, wantIndex: 80 * 300},
+		{id: 4, wantFee: 400, wantIndex: -1},
+	}
+
+	for _, tt := range tests {
+		op, ok := operators.Operator(tt.id)
+		if !ok {
+			t.Errorf("Operator(%d) not found", tt.id)
+			continue
+		}
+		if op.Fee.Int64() != tt.wantFee {
+			t.Errorf("Operator(%d).Fee = %s, want %d", tt.id, op.Fee, tt.wantFee)
+		}
+		if (op.PendingFee != nil) != (tt.wantPendingFee != 0) || (op.PendingFee != nil && op.PendingFee.Int64() != tt.wantPendingFee) {
+			t.Errorf("Operator(%d).PendingFee = %v, want %d", tt.id, op.PendingFee, tt.wantPendingFee)
+		}
+		if op.Private != tt.wantPrivate {
+			t.Errorf("Operator(%d).Private = %v, want %v", tt.id, op.Private, tt.wantPrivate)
+		}
+		if !slices.Equal(op.Whitelisted, tt.wantWhitelisted) {
+			t.Errorf("Operator(%d).Whitelisted = %v, want %v", tt.id, op.Whitelisted, tt.wantWhitelisted)
+		}
+		if op.WhitelistingContract != tt.wantWhitelisting {
+			t.Errorf("Operator(%d).WhitelistingContract = %s, want %s", tt.id, op.WhitelistingContract.Hex(), tt.wantWhitelisting.Hex())
+		}
+		if op.Removed != tt.wantRemoved {
+			t.Errorf("Operator(%d).Removed = %v, want %v", tt.id, op.Removed, tt.wantRemoved)
+		}
+		if _, index := operators.fee(tt.id, 100); (index == nil) != (tt.wantIndex < 0) || (index != nil && index.Int64() != tt.wantIndex) {
+			t.Errorf("fee(%d, 100) index = %v, want %d", tt.id, index, tt.wantIndex)
+		}
+	}
+
+	if got := operators.Operators([]uint64{4, 1, 99}); len(got) != 2 || got[0].ID != 4 || got[1].ID != 1 {
+		t.Errorf("Operators() = %v, want operators 4 and 1", got)
+	}
+}
+
+func TestOperatorIDs(t *testing.T) {
+	clusters := []Cluster{
+		{OperatorIds: []uint64{4, 3, 2, 1}, ISSVNetworkCoreCluster: ISSVNetworkCoreCluster{ValidatorCount: 1}},
+		{OperatorIds: []uint64{5, 6, 3, 4}, ISSVNetworkCoreCluster: ISSVNetworkCoreCluster{ValidatorCount: 2}},
+		{OperatorIds: []uint64{7, 8, 9, 10}},
+	}
+
+	want := []uint64{1, 2, 3, 4, 5, 6}
+	if got := OperatorIDs(clusters); !slices.Equal(got, want) {
+		t.Errorf("OperatorIDs() = %v, want %v", got, want)
+	}
+}
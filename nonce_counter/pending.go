@@ -0,0 +1,124 @@
+package noncecounter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// pendingBlockReader is the part of the Ethereum client needed to read the pending block.
+type pendingBlockReader interface {
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+}
+
+// PendingNonce returns the nonce the next registration of owner will consume once the registrations waiting in the
+// pending block are included, and whether owner is tracked. It equals NextNonce unless PendingNonces is enabled.
+func (nc *NonceCounter) PendingNonce(owner common.Address) (uint64, bool) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	nonce, ok := nc.addressToNonce[owner.Hex()]
+	return nonce + nc.pending[owner.Hex()], ok
+}
+
+// PendingNonces returns a copy of the pending nonces of every tracked owner.
+func (nc *NonceCounter) PendingNonces() map[common.Address]uint64 {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	nonces := make(map[common.Address]uint64, len(nc.addressToNonce))
+	for address, nonce := range nc.addressToNonce {
+		nonces[common.HexToAddress(address)] = nonce + nc.pending[address]
+	}
+	return nonces
+}
+
+// refreshPending replaces the pending registrations with the ones in the current pending block. They are cleared
+// when the pending block can't be read, so a stale prediction is never reported.
+func (nc *NonceCounter) refreshPending(ctx context.Context, client pendingBlockReader, head uint64) {
+	block, err := client.BlockByNumber(ctx, big.NewInt(int64(rpc.PendingBlockNumber)))
+	if err != nil {
+		nc.Logger().Warn("failed to fetch pending block", "error", err)
+		nc.setPending(nil)
+		return
+	}
+	// Nodes without a transaction pool return the head as the pending block, its logs are counted already
+	if block.NumberU64() <= head {
+		nc.setPending(nil)
+		return
+	}
+
+	pending, err := nc.pendingRegistrations(block.Transactions())
+	if err != nil {
+		nc.Logger().Warn("failed to decode pending block", "block", block.NumberU64(), "error", err)
+	}
+	if nc.setPending(pending) {
+		nc.printNonces()
+	}
+}
+
+// pendingRegistrations counts the validators registered by every tracked owner in txs. Transactions whose calldata
+// can't be decoded are skipped, and the first such error is returned along with the counts.
+func (nc *NonceCounter) pendingRegistrations(txs types.Transactions) (map[string]uint64, error) {
+	contract := common.HexToAddress(nc.contractAddress)
+	pending := map[string]uint64{}
+	var decodeErr error
+	for _, tx := range txs {
+		if tx.To() == nil || *tx.To() != contract {
+			continue
+		}
+
+		call, err := DecodeRegistrationCall(nc.contractAbi, tx.Data())
+		if errors.Is(err, ErrNotRegistration) {
+			continue
+		}
+		if err == nil {
+			var sender common.Address
+			sender, err = types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+			if err == nil && nc.isTracked(sender) {
+				pending[sender.Hex()] += uint64(len(call.PublicKeys))
+			}
+		}
+		if err != nil && decodeErr == nil {
+			decodeErr = fmt.Errorf("transaction %s: %w", tx.Hash().Hex(), err)
+		}
+	}
+	return pending, decodeErr
+}
+
+// setPending replaces the pending registration counts and returns whether they changed.
+func (nc *NonceCounter) setPending(pending map[string]uint64) bool {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	changed := !maps.Equal(nc.pending, pending)
+	nc.pending = pending
+	return changed
+}
+
+// settlePending drops the pending registrations of the owners whose nonce changed in the increments recorded from
+// index from on. They may be the ones just mined, counting them on top of the confirmed nonce would report them twice
+// until the pending block is read again.
+func (nc *NonceCounter) settlePending(from int) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	for _, increment := range nc.history[from:] {
+		delete(nc.pending, increment.Owner.Hex())
+	}
+}
+
+// isTracked reports whether the nonces of owner are counted.
+func (nc *NonceCounter) isTracked(owner common.Address) bool {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	_, ok := nc.addressToNonce[owner.Hex()]
+	return ok
+}
@@ -0,0 +1,158 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// fakePendingBlockReader serves a fixed pending block.
+type fakePendingBlockReader struct {
+	block *types.Block
+	err   error
+}
+
+func (f fakePendingBlockReader) BlockByNumber(context.Context, *big.Int) (*types.Block, error) {
+	return f.block, f.err
+}
+
+// newSignedTx signs a transaction from key to the given address with the given calldata.
+func newSignedTx(t testing.TB, key *ecdsa.PrivateKey, nonce uint64, to common.Address, data []byte) *types.Transaction {
+	t.Helper()
+
+	chainID := big.NewInt(17000)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1),
+		Gas:       1_000_000,
+		To:        &to,
+		Data:      data,
+	})
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+	return tx
+}
+
+func TestRefreshPending(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	contract := common.HexToAddress("0x38A4794cCEd47d3baf7370CcC43B560D3a1beEFA")
+	ownerKey, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	otherKey, _ := crypto.HexToECDSA("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a")
+	owner := crypto.PubkeyToAddress(ownerKey.PublicKey)
+	untracked := crypto.PubkeyToAddress(otherKey.PublicKey)
+
+	firstKey := bytes.Repeat([]byte{0x01}, 48)
+	secondKey := bytes.Repeat([]byte{0x02}, 48)
+	thirdKey := bytes.Repeat([]byte{0x03}, 48)
+	removeValidator, err := contractAbi.Pack("removeValidator", firstKey, []uint64{1, 2, 3, 4},
+		ISSVNetworkCoreCluster{Balance: new(big.Int)})
+	if err != nil {
+		t.Fatalf("failed to pack removeValidator: %v", err)
+	}
+
+	txs := types.Transactions{
+		newSignedTx(t, ownerKey, 0, contract, packRegistration(t, contractAbi, false, firstKey)),
+		newSignedTx(t, ownerKey, 1, contract, packRegistration(t, contractAbi, true, secondKey, thirdKey)),
+		newSignedTx(t, ownerKey, 2, contract, removeValidator),
+		newSignedTx(t, ownerKey, 3, common.HexToAddress("0x01"), packRegistration(t, contractAbi, false, firstKey)),
+		newSignedTx(t, otherKey, 0, contract, packRegistration(t, contractAbi, false, firstKey)),
+	}
+	pendingBlock := func(number int64) *types.Block {
+		return types.NewBlockWithHeader(&types.Header{Number: big.NewInt(number)}).WithBody(types.Body{Transactions: txs})
+	}
+
+	tests := []struct {
+		name        string
+		client      fakePendingBlockReader
+		wantPending uint64
+	}{
+		{
+			name:        "registrations of tracked owners to the contract are counted",
+			client:      fakePendingBlockReader{block: pendingBlock(101)},
+			wantPending: 6,
+		},
+		{
+			name:        "a pending block at the head is already counted",
+			client:      fakePendingBlockReader{block: pendingBlock(100)},
+			wantPending: 3,
+		},
+		{
+			name:        "prediction is cleared when the pending block is unavailable",
+			client:      fakePendingBlockReader{err: errors.New("pending block not supported")},
+			wantPending: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nc := &NonceCounter{
+				contractAddress: contract.Hex(),
+				contractAbi:     contractAbi,
+				addresses:       []string{owner.Hex()},
+				addressToNonce:  map[string]uint64{owner.Hex(): 3},
+				pending:         map[string]uint64{owner.Hex(): 1},
+			}
+
+			nc.refreshPending(context.Background(), tt.client, 100)
+			if nonce, ok := nc.PendingNonce(owner); !ok || nonce != tt.wantPending {
+				t.Errorf("PendingNonce() = %d, %v, want %d, true", nonce, ok, tt.wantPending)
+			}
+			if nonce, _ := nc.NextNonce(owner); nonce != 3 {
+				t.Errorf("NextNonce() = %d, want the confirmed nonce 3", nonce)
+			}
+			if _, ok := nc.PendingNonce(untracked); ok {
+				t.Errorf("PendingNonce() tracks an unconfigured address")
+			}
+			if nonces := nc.PendingNonces(); len(nonces) != 1 || nonces[owner] != tt.wantPending {
+				t.Errorf("PendingNonces() = %v, want only %s at %d", nonces, owner.Hex(), tt.wantPending)
+			}
+		})
+	}
+}
+
+func TestPendingSettledByMinedRegistrations(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	otherOwner := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+	var out bytes.Buffer
+	nc := newReplayNonceCounter(t, &out, owner, otherOwner)
+	nc.setPending(map[string]uint64{owner.Hex(): 1, otherOwner.Hex(): 2})
+
+	// The pending registration of owner is mined, the one of otherOwner still waits
+	mined := newValidatorAddedLog(t, contractAbi, owner)
+	mined.BlockNumber = 10
+	if err := nc.processBatch(context.Background(), Batch{FromBlock: 10, ToBlock: 10, Logs: 1}, []types.Log{mined}, nil); err != nil {
+		t.Fatalf("processBatch() error = %v", err)
+	}
+
+	tests := []struct {
+		owner       common.Address
+		wantNonce   uint64
+		wantPending uint64
+	}{
+		{owner: owner, wantNonce: 1, wantPending: 1},
+		{owner: otherOwner, wantNonce: 0, wantPending: 2},
+	}
+	for _, tt := range tests {
+		if nonce, _ := nc.NextNonce(tt.owner); nonce != tt.wantNonce {
+			t.Errorf("NextNonce(%s) = %d, want %d", tt.owner.Hex(), nonce, tt.wantNonce)
+		}
+		if nonce, _ := nc.PendingNonce(tt.owner); nonce != tt.wantPending {
+			t.Errorf("PendingNonce(%s) = %d, want %d", tt.owner.Hex(), nonce, tt.wantPending)
+		}
+	}
+	if want := "Address: " + owner.Hex() + ", Nonce: 1\n"; !strings.Contains(out.String(), want) {
+		t.Errorf("printed nonces =\n%s\nwant the mined registration counted once: %s", out.String(), want)
+	}
+}
@@ -0,0 +1,257 @@
+package noncecounter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// publishTimeout bounds the publication of the events of a block range.
+const publishTimeout = 30 * time.Second
+
+// Message is a decoded event published to a message bus.
+type Message struct {
+	// Key is the checksummed address of the event's owner, so every event of an owner lands on the same partition or
+	// subject.
+	Key string
+	// ID is unique per event and stable across republications, "<block>-<log index>", for consumers to drop
+	// duplicates.
+	ID    string
+	Value []byte
+}
+
+// Publisher publishes messages to a message bus. Publish returns once the bus accepted every message, in order, so
+// the events are never acknowledged before they are durable.
+type Publisher interface {
+	Publish(ctx context.Context, messages []Message) error
+	Close() error
+}
+
+// ValidatorAddedRecord is the published value of a ValidatorAdded event.
+type ValidatorAddedRecord struct {
+	Owner       string   `json:"owner"`
+	PublicKey   string   `json:"publicKey"`
+	OperatorIds []uint64 `json:"operatorIds"`
+	Shares      string   `json:"shares"`
+	Cluster     struct {
+		ValidatorCount  uint32 `json:"validatorCount"`
+		NetworkFeeIndex uint64 `json:"networkFeeIndex"`
+		Index           uint64 `json:"index"`
+		Active          bool   `json:"active"`
+		// Balance is a decimal string, it overflows JSON numbers.
+		Balance string `json:"balance"`
+	} `json:"cluster"`
+	BlockNumber uint64 `json:"blockNumber"`
+	BlockHash   string `json:"blockHash"`
+	TxHash      string `json:"txHash"`
+	LogIndex    uint   `json:"logIndex"`
+}
+
+// EventPublisher publishes every ValidatorAdded event of the contract with at least once delivery. The events of a
+// block range are published after it is processed and a checkpoint of the last published block is persisted. Events
+// that failed to publish are retried along with the next block range, and events at or before the checkpoint are
+// skipped, so a counter restarted from an earlier block republishes only what was not acknowledged. The scan
+// checkpoint of nc is withheld while publishing fails, so a counter resumed from it rescans the unpublished events.
+type EventPublisher struct {
+	publisher      Publisher
+	checkpointPath string
+
+	mu         sync.Mutex
+	pending    []Message
+	checkpoint *uint64
+}
+
+// publisherCheckpoint is the persisted checkpoint of an EventPublisher.
+type publisherCheckpoint struct {
+	Block uint64 `json:"block"`
+}
+
+// NewEventPublisher returns an event publisher to publisher, loading the checkpoint at checkpointPath. The checkpoint
+// is only kept in memory when checkpointPath is empty.
+func NewEventPublisher(publisher Publisher, checkpointPath string) (*EventPublisher, error) {
+	p := &EventPublisher{publisher: publisher, checkpointPath: checkpointPath}
+	if checkpointPath == "" {
+		return p, nil
+	}
+
+	data, err := os.ReadFile(checkpointPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read publisher checkpoint: %w", err)
+	}
+	var checkpoint publisherCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to decode publisher checkpoint: %w", err)
+	}
+	p.checkpoint = &checkpoint.Block
+	return p, nil
+}
+
+// Checkpoint returns the last block whose events were all published, and false if none was.
+func (p *EventPublisher) Checkpoint() (uint64, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.checkpoint == nil {
+		return 0, false
+	}
+	return *p.checkpoint, true
+}
+
+// Attach publishes the ValidatorAdded events of nc after every block range it processes, as an OnDeliver hook. It must
+// be called before Start.
+func (p *EventPublisher) Attach(nc *NonceCounter) error {
+	if err := Handle(nc.Registry(), "ValidatorAdded", func(e *SSVNetworkValidatorAdded, vLog types.Log) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		if p.checkpoint != nil && vLog.BlockNumber <= *p.checkpoint {
+			return
+		}
+		message, err := newValidatorAddedMessage(e, vLog)
+		if err != nil {
+			nc.Logger().Error("failed to encode ValidatorAdded event", "tx_hash", vLog.TxHash.Hex(), "log_index", vLog.Index,
+				"error", err)
+			return
+		}
+		p.pending = append(p.pending, message)
+	}); err != nil {
+		return err
+	}
+
+	nc.OnDeliver(func(batch Batch) error {
+		if err := p.publish(batch); err != nil {
+			// The events stay pending and are published along with the next block range
+			return fmt.Errorf("failed to publish events, retrying with the next block range: %w", err)
+		}
+		return nil
+	})
+	return nil
+}
+
+// publish publishes the pending events and moves the checkpoint to the end of batch.
+func (p *EventPublisher) publish(batch Batch) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.pending) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+		defer cancel()
+		if err := p.publisher.Publish(ctx, p.pending); err != nil {
+			return err
+		}
+		p.pending = nil
+	}
+
+	if p.checkpoint != nil && batch.ToBlock <= *p.checkpoint {
+		return nil
+	}
+	block := batch.ToBlock
+	p.checkpoint = &block
+	if p.checkpointPath == "" {
+		return nil
+	}
+	data, err := json.Marshal(publisherCheckpoint{Block: block})
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(p.checkpointPath, data); err != nil {
+		return fmt.Errorf("failed to save publisher checkpoint: %w", err)
+	}
+	return nil
+}
+
+// newValidatorAddedMessage returns the message of a ValidatorAdded event, keyed by its owner.
+func newValidatorAddedMessage(e *SSVNetworkValidatorAdded, vLog types.Log) (Message, error) {
+	record := ValidatorAddedRecord{
+		Owner:       e.Owner.Hex(),
+		PublicKey:   hexutil.Encode(e.PublicKey),
+		OperatorIds: e.OperatorIds,
+		Shares:      hexutil.Encode(e.Shares),
+		BlockNumber: vLog.BlockNumber,
+		BlockHash:   vLog.BlockHash.Hex(),
+		TxHash:      vLog.TxHash.Hex(),
+		LogIndex:    vLog.Index,
+	}
+	record.Cluster.ValidatorCount = e.Cluster.ValidatorCount
+	record.Cluster.NetworkFeeIndex = e.Cluster.NetworkFeeIndex
+	record.Cluster.Index = e.Cluster.Index
+	record.Cluster.Active = e.Cluster.Active
+	if e.Cluster.Balance != nil {
+		record.Cluster.Balance = e.Cluster.Balance.String()
+	}
+
+	value, err := json.Marshal(record)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{
+		Key:   e.Owner.Hex(),
+		ID:    fmt.Sprintf("%d-%d", vLog.BlockNumber, vLog.Index),
+		Value: value,
+	}, nil
+}
+
+// WriterPublisher publishes messages as JSON lines of their key, ID and value to a writer, such as a file or stdout.
+type WriterPublisher struct {
+	mu sync.Mutex
+	w  io.Writer
+	// file is the file of a file publisher, nil otherwise
+	file *os.File
+}
+
+// writerMessage is the JSON line of a message.
+type writerMessage struct {
+	Key   string          `json:"key"`
+	ID    string          `json:"id"`
+	Value json.RawMessage `json:"value"`
+}
+
+// NewWriterPublisher returns a publisher writing to w, which it never closes.
+func NewWriterPublisher(w io.Writer) *WriterPublisher {
+	return &WriterPublisher{w: w}
+}
+
+// NewFilePublisher returns a publisher appending to the file at path, synced after every publication.
+func NewFilePublisher(path string) (*WriterPublisher, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open publisher file: %w", err)
+	}
+	return &WriterPublisher{w: file, file: file}, nil
+}
+
+// Publish writes the messages, one per line.
+func (p *WriterPublisher) Publish(_ context.Context, messages []Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	encoder := json.NewEncoder(p.w)
+	for _, message := range messages {
+		if err := encoder.Encode(writerMessage{Key: message.Key, ID: message.ID, Value: message.Value}); err != nil {
+			return err
+		}
+	}
+	if p.file != nil {
+		return p.file.Sync()
+	}
+	return nil
+}
+
+// Close closes the file of a file publisher.
+func (p *WriterPublisher) Close() error {
+	if p.file == nil {
+		return nil
+	}
+	return p.file.Close()
+}
@@ -0,0 +1,232 @@
+package noncecounter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// fakePublisher records the published messages, failing while fail is set.
+type fakePublisher struct {
+	fail      bool
+	published []Message
+}
+
+func (f *fakePublisher) Publish(_ context.Context, messages []Message) error {
+	if f.fail {
+		return errors.New("bus unavailable")
+	}
+	f.published = append(f.published, messages...)
+	return nil
+}
+
+func (f *fakePublisher) Close() error {
+	return nil
+}
+
+// publishedIDs returns the IDs of messages.
+func publishedIDs(messages []Message) []string {
+	ids := make([]string, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+	return ids
+}
+
+func TestEventPublisher(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	untracked := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+	checkpointPath := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	logs := map[uint64][]types.Log{}
+	for i, spec := range []struct {
+		owner common.Address
+		block uint64
+	}{{owner, 10}, {untracked, 10}, {owner, 20}, {untracked, 30}} {
+		vLog := newValidatorAddedLog(t, contractAbi, spec.owner)
+		vLog.BlockNumber = spec.block
+		vLog.Index = uint(i)
+		logs[spec.block] = append(logs[spec.block], vLog)
+	}
+	// run processes the blocks of logs from one on, failing to publish those listed in failing
+	run := func(publisher *fakePublisher, from uint64, failing ...uint64) *EventPublisher {
+		t.Helper()
+		eventPublisher, err := NewEventPublisher(publisher, checkpointPath)
+		if err != nil {
+			t.Fatalf("NewEventPublisher() error = %v", err)
+		}
+		nc := newReplayNonceCounter(t, &bytes.Buffer{}, owner)
+		if err := eventPublisher.Attach(nc); err != nil {
+			t.Fatalf("Attach() error = %v", err)
+		}
+		for _, block := range []uint64{10, 20, 30} {
+			if block < from {
+				continue
+			}
+			publisher.fail = false
+			for _, failing := range failing {
+				publisher.fail = publisher.fail || failing == block
+			}
+			if err := nc.processBatch(context.Background(), Batch{FromBlock: block, ToBlock: block}, logs[block], nil); err != nil {
+				t.Fatalf("processBatch() error = %v", err)
+			}
+		}
+		return eventPublisher
+	}
+
+	// The events of block 20 fail to publish and are published along with the ones of block 30
+	first := &fakePublisher{}
+	eventPublisher := run(first, 0, 20)
+	if got := publishedIDs(first.published); len(got) != 4 || got[2] != "20-2" || got[3] != "30-3" {
+		t.Errorf("published = %v, want every event in order", got)
+	}
+	if first.published[0].Key != owner.Hex() || first.published[1].Key != untracked.Hex() {
+		t.Errorf("message keys = %s, %s, want the owners", first.published[0].Key, first.published[1].Key)
+	}
+	var record ValidatorAddedRecord
+	if err := json.Unmarshal(first.published[0].Value, &record); err != nil || record.Owner != owner.Hex() ||
+		len(record.OperatorIds) != 4 || record.Cluster.Balance != "1000000000000000000" || record.BlockNumber != 10 {
+		t.Errorf("published value = %s, %v", first.published[0].Value, err)
+	}
+	if block, ok := eventPublisher.Checkpoint(); !ok || block != 30 {
+		t.Errorf("Checkpoint() = %d, %v, want 30, true", block, ok)
+	}
+
+	// A restart rescanning from the start publishes nothing the checkpoint covers
+	restarted := &fakePublisher{}
+	run(restarted, 0)
+	if len(restarted.published) != 0 {
+		t.Errorf("published after restart = %v, want none", publishedIDs(restarted.published))
+	}
+
+	// Events pending when the process stops are published again after a restart
+	if err := os.WriteFile(checkpointPath, []byte(`{"block":10}`), 0o644); err != nil {
+		t.Fatalf("failed to write checkpoint: %v", err)
+	}
+	stopped := &fakePublisher{}
+	run(stopped, 0, 20, 30)
+	resumed := &fakePublisher{}
+	run(resumed, 20)
+	if got := publishedIDs(resumed.published); len(stopped.published) != 0 || len(got) != 2 || got[0] != "20-2" {
+		t.Errorf("published after resuming = %v, want the events of blocks 20 and 30", got)
+	}
+}
+
+func TestWriterPublisher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	messages := []Message{
+		{Key: "0xA", ID: "1-0", Value: []byte(`{"owner":"0xA"}`)},
+		{Key: "0xB", ID: "1-1", Value: []byte(`{"owner":"0xB"}`)},
+	}
+
+	for i := 0; i < 2; i++ {
+		publisher, err := NewFilePublisher(path)
+		if err != nil {
+			t.Fatalf("NewFilePublisher() error = %v", err)
+		}
+		if err := publisher.Publish(context.Background(), messages[i:i+1]); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+		if err := publisher.Close(); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open published file: %v", err)
+	}
+	defer file.Close()
+	var lines []string
+	for scanner := bufio.NewScanner(file); scanner.Scan(); {
+		lines = append(lines, scanner.Text())
+	}
+	want := []string{
+		`{"key":"0xA","id":"1-0","value":{"owner":"0xA"}}`,
+		`{"key":"0xB","id":"1-1","value":{"owner":"0xB"}}`,
+	}
+	if len(lines) != len(want) || lines[0] != want[0] || lines[1] != want[1] {
+		t.Errorf("published file =\n%v\nwant\n%v", lines, want)
+	}
+}
+
+func TestEventPublisherWithholdsStoreCheckpoint(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	dir := t.TempDir()
+	storePath, checkpointPath := filepath.Join(dir, "nonces.db"), filepath.Join(dir, "checkpoint.json")
+
+	logs := map[uint64][]types.Log{}
+	for i, block := range []uint64{10, 20} {
+		vLog := newValidatorAddedLog(t, contractAbi, owner)
+		vLog.BlockNumber = block
+		vLog.Index = uint(i)
+		logs[block] = append(logs[block], vLog)
+	}
+	// start attaches a reopened store before the publisher, and returns the counter and the block to resume from
+	start := func(publisher *fakePublisher) (*NonceCounter, *SQLStore, uint64) {
+		t.Helper()
+		store, err := OpenSQLStore(storePath)
+		if err != nil {
+			t.Fatalf("OpenSQLStore() error = %v", err)
+		}
+		t.Cleanup(func() { store.Close() })
+		nc := newReplayNonceCounter(t, &bytes.Buffer{}, owner)
+		resume, _, err := store.Attach(nc)
+		if err != nil {
+			t.Fatalf("SQLStore.Attach() error = %v", err)
+		}
+		eventPublisher, err := NewEventPublisher(publisher, checkpointPath)
+		if err != nil {
+			t.Fatalf("NewEventPublisher() error = %v", err)
+		}
+		if err := eventPublisher.Attach(nc); err != nil {
+			t.Fatalf("EventPublisher.Attach() error = %v", err)
+		}
+		return nc, store, resume
+	}
+
+	// The events of block 20 fail to publish when the process stops
+	publisher := &fakePublisher{}
+	nc, store, _ := start(publisher)
+	for _, block := range []uint64{10, 20} {
+		publisher.fail = block == 20
+		if err := nc.processBatch(context.Background(), Batch{FromBlock: block, ToBlock: block}, logs[block], nil); err != nil {
+			t.Fatalf("processBatch() error = %v", err)
+		}
+	}
+	if block, ok, err := store.Checkpoint(context.Background()); err != nil || !ok || block != 10 {
+		t.Fatalf("store Checkpoint() = %d, %v, %v, want 10, true", block, ok, err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// The restarted counter resumes from the store, before the unpublished events, and publishes them
+	restarted := &fakePublisher{}
+	nc, store, resume := start(restarted)
+	if nonce, _ := nc.NextNonce(owner); resume != 11 || nonce != 1 {
+		t.Fatalf("resumed at block %d with nonce %d, want block 11 and nonce 1", resume, nonce)
+	}
+	if err := nc.processBatch(context.Background(), Batch{FromBlock: 11, ToBlock: 20}, logs[20], nil); err != nil {
+		t.Fatalf("processBatch() error = %v", err)
+	}
+	if got := publishedIDs(restarted.published); len(got) != 1 || got[0] != "20-1" {
+		t.Errorf("published after restart = %v, want the events of block 20", got)
+	}
+	if block, ok, err := store.Checkpoint(context.Background()); err != nil || !ok || block != 20 {
+		t.Errorf("store Checkpoint() after restart = %d, %v, %v, want 20, true", block, ok, err)
+	}
+	if nonce, _ := nc.NextNonce(owner); nonce != 2 {
+		t.Errorf("NextNonce() after restart = %d, want 2", nonce)
+	}
+}
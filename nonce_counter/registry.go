@@ -0,0 +1,145 @@
+package noncecounter
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Registry maps contract event IDs to typed decoders and the handlers subscribed to them,
+// allowing a single log scan to feed every subsystem interested in the contract's events.
+type Registry struct {
+	contractAbi abi.ABI
+	mu          sync.RWMutex
+	entries     map[common.Hash]*registryEntry
+}
+
+// registryEntry holds the decoder and handlers of a single event.
+type registryEntry struct {
+	name      string
+	eventType reflect.Type
+	decode    func(vLog types.Log) (any, error)
+	handlers  []func(event any, vLog types.Log)
+}
+
+// NewRegistry creates an empty Registry for the events defined in contractAbi.
+func NewRegistry(contractAbi abi.ABI) *Registry {
+	return &Registry{
+		contractAbi: contractAbi,
+		entries:     map[common.Hash]*registryEntry{},
+	}
+}
+
+// Handle subscribes handler to eventName. Matching logs are decoded into a new E, whose fields must be named after
+// the event arguments in camel case. All handlers of an event must share the same event type.
+// Handlers are called sequentially in log order, so they need no synchronization among themselves.
+func Handle[E any](r *Registry, eventName string, handler func(event *E, vLog types.Log)) error {
+	event, ok := r.contractAbi.Events[eventName]
+	if !ok {
+		return fmt.Errorf("event %s not found in contract ABI", eventName)
+	}
+
+	eventType := reflect.TypeOf((*E)(nil)).Elem()
+	for _, input := range event.Inputs {
+		if _, ok := eventType.FieldByName(abi.ToCamelCase(input.Name)); !ok {
+			return fmt.Errorf("type %s has no field for %s argument %s", eventType, eventName, input.Name)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[event.ID]
+	if !ok {
+		entry = &registryEntry{
+			name:      eventName,
+			eventType: eventType,
+			decode: func(vLog types.Log) (any, error) {
+				out := new(E)
+				if err := decodeEvent(r.contractAbi, event, vLog, out); err != nil {
+					return nil, err
+				}
+				return out, nil
+			},
+		}
+		r.entries[event.ID] = entry
+	}
+	if entry.eventType != eventType {
+		return fmt.Errorf("event %s is already decoded into %s, cannot decode into %s", eventName, entry.eventType, eventType)
+	}
+
+	entry.handlers = append(entry.handlers, func(event any, vLog types.Log) {
+		handler(event.(*E), vLog)
+	})
+	return nil
+}
+
+// lookup returns the entry registered for the event that emitted vLog, or nil if nobody is interested in it.
+func (r *Registry) lookup(vLog types.Log) *registryEntry {
+	if len(vLog.Topics) == 0 {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.entries[vLog.Topics[0]]
+}
+
+// dispatch hands a decoded event to every handler of its entry.
+func (e *registryEntry) dispatch(event any, vLog types.Log) {
+	for _, handler := range e.handlers {
+		handler(event, vLog)
+	}
+}
+
+// decodeEvent validates vLog against event and decodes its data and indexed topics into out, a pointer to a struct.
+func decodeEvent(contractAbi abi.ABI, event abi.Event, vLog types.Log, out any) error {
+	if len(vLog.Topics) == 0 || vLog.Topics[0] != event.ID {
+		return ErrWrongEvent
+	}
+
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+
+	// Signature topic plus one topic per indexed argument
+	if len(vLog.Topics) != len(indexed)+1 {
+		return fmt.Errorf("%w: expected %d topics, got %d", ErrMalformedLog, len(indexed)+1, len(vLog.Topics))
+	}
+	for i, arg := range indexed {
+		if arg.Type.T == abi.AddressTy && !isAddressTopic(vLog.Topics[i+1]) {
+			return fmt.Errorf("%w: %s topic %s is not an address", ErrMalformedLog, arg.Name, vLog.Topics[i+1].Hex())
+		}
+	}
+
+	if err := contractAbi.UnpackIntoInterface(out, event.Name, vLog.Data); err != nil {
+		return fmt.Errorf("%w: failed to decode data: %v", ErrMalformedLog, err)
+	}
+	if err := abi.ParseTopics(out, indexed, vLog.Topics[1:]); err != nil {
+		return fmt.Errorf("%w: failed to decode topics: %v", ErrMalformedLog, err)
+	}
+
+	// Generated bindings carry the raw log alongside the decoded fields
+	if raw := reflect.ValueOf(out).Elem().FieldByName("Raw"); raw.IsValid() && raw.Type() == reflect.TypeOf(vLog) {
+		raw.Set(reflect.ValueOf(vLog))
+	}
+	return nil
+}
+
+// isAddressTopic reports whether topic is a left padded 20 byte address.
+func isAddressTopic(topic common.Hash) bool {
+	for _, b := range topic[:common.HashLength-common.AddressLength] {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,121 @@
+package noncecounter
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type operatorRemovedEvent struct {
+	OperatorId uint64
+}
+
+func TestHandle(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+
+	tests := []struct {
+		name     string
+		register func(r *Registry) error
+		wantErr  bool
+	}{
+		{
+			name: "known event",
+			register: func(r *Registry) error {
+				return Handle(r, "OperatorRemoved", func(*operatorRemovedEvent, types.Log) {})
+			},
+		},
+		{
+			name: "unknown event",
+			register: func(r *Registry) error {
+				return Handle(r, "Transfer", func(*operatorRemovedEvent, types.Log) {})
+			},
+			wantErr: true,
+		},
+		{
+			name: "type missing an argument field",
+			register: func(r *Registry) error {
+				return Handle(r, "OperatorRemoved", func(*struct{ Owner common.Address }, types.Log) {})
+			},
+			wantErr: true,
+		},
+		{
+			name: "conflicting event types",
+			register: func(r *Registry) error {
+				if err := Handle(r, "ValidatorAdded", func(*ValidatorAddedEvent, types.Log) {}); err != nil {
+					return err
+				}
+				return Handle(r, "ValidatorAdded", func(*struct {
+					Owner       common.Address
+					OperatorIds []uint64
+					PublicKey   []byte
+					Shares      []byte
+					Cluster     struct{}
+				}, types.Log) {
+				})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.register(NewRegistry(contractAbi))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Handle() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegistryDispatchOrder(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+
+	nc := &NonceCounter{
+		concurrency: 4,
+		registry:    NewRegistry(contractAbi),
+	}
+
+	var got []string
+	if err := Handle(nc.registry, "ValidatorAdded", func(event *ValidatorAddedEvent, _ types.Log) {
+		got = append(got, "added "+event.Owner.Hex())
+	}); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if err := Handle(nc.registry, "OperatorRemoved", func(event *operatorRemovedEvent, _ types.Log) {
+		got = append(got, fmt.Sprintf("removed %d", event.OperatorId))
+	}); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	operatorRemoved := func(id uint64) types.Log {
+		return types.Log{Topics: []common.Hash{
+			contractAbi.Events["OperatorRemoved"].ID,
+			common.BigToHash(new(big.Int).SetUint64(id)),
+		}}
+	}
+	logs := []types.Log{
+		operatorRemoved(1),
+		newValidatorAddedLog(t, contractAbi, owner),
+		{Topics: []common.Hash{common.HexToHash("0x01")}},
+		operatorRemoved(2),
+	}
+
+	if _, err := nc.FindNonces(context.Background(), logs); err != nil {
+		t.Fatalf("FindNonces() error = %v", err)
+	}
+
+	want := []string{"removed 1", "added " + owner.Hex(), "removed 2"}
+	if len(got) != len(want) {
+		t.Fatalf("dispatched %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("dispatched[%d] = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,297 @@
+package noncecounter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// maxRecordedLogSize is the longest line a log recording may hold.
+const maxRecordedLogSize = 16 * 1024 * 1024
+
+// recordedRange closes the logs of a block range in a recording, so a replay processes the same block ranges as the
+// recorded run, empty ones included.
+type recordedRange struct {
+	FromBlock uint64 `json:"fromBlock"`
+	ToBlock   uint64 `json:"toBlock"`
+	// BlockTimestamps and AttributeSubmissions are the enrichments the recorded run had enabled.
+	BlockTimestamps      bool `json:"blockTimestamps,omitempty"`
+	AttributeSubmissions bool `json:"attributeSubmissions,omitempty"`
+	// Timestamps and Transactions are what the enrichments fetched for the range, a replay serves them instead of the
+	// RPC.
+	Timestamps   map[uint64]uint64                   `json:"timestamps,omitempty"`
+	Transactions map[common.Hash]recordedTransaction `json:"transactions,omitempty"`
+}
+
+// recordedTransaction is a transaction fetched to attribute a submission, with its recovered sender.
+type recordedTransaction struct {
+	Transaction *types.Transaction `json:"transaction"`
+	Sender      common.Address     `json:"sender"`
+}
+
+// recordedLine is a line of a recording that is not a log.
+type recordedLine struct {
+	Range *recordedRange `json:"blockRange"`
+}
+
+// rangeEnrichment serves the block timestamps and transactions the enrichments of a block range need. With a clock
+// and a client it fetches them and keeps what it fetched for the recording, without them it serves a recorded range.
+type rangeEnrichment struct {
+	clock  timeSource
+	client transactionReader
+
+	mu           sync.Mutex
+	timestamps   map[uint64]uint64
+	transactions map[common.Hash]recordedTransaction
+}
+
+// newRangeEnrichment creates the rangeEnrichment of a live block range, fetching through clock and client.
+func newRangeEnrichment(clock timeSource, client transactionReader) *rangeEnrichment {
+	return &rangeEnrichment{
+		clock:        clock,
+		client:       client,
+		timestamps:   map[uint64]uint64{},
+		transactions: map[common.Hash]recordedTransaction{},
+	}
+}
+
+// Times returns the timestamps of the given blocks.
+func (re *rangeEnrichment) Times(ctx context.Context, blocks []uint64) (map[uint64]time.Time, error) {
+	re.mu.Lock()
+	defer re.mu.Unlock()
+
+	if re.clock != nil {
+		times, err := re.clock.Times(ctx, blocks)
+		if err != nil {
+			return nil, err
+		}
+		for block, timestamp := range times {
+			re.timestamps[block] = uint64(timestamp.Unix())
+		}
+		return times, nil
+	}
+
+	times := make(map[uint64]time.Time, len(blocks))
+	for _, block := range blocks {
+		timestamp, ok := re.timestamps[block]
+		if !ok {
+			return nil, fmt.Errorf("timestamp of block %d not recorded", block)
+		}
+		times[block] = time.Unix(int64(timestamp), 0)
+	}
+	return times, nil
+}
+
+// TransactionByHash returns the transaction with the given hash.
+func (re *rangeEnrichment) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	if re.client != nil {
+		tx, pending, err := re.client.TransactionByHash(ctx, hash)
+		if err != nil {
+			return nil, false, err
+		}
+		re.mu.Lock()
+		defer re.mu.Unlock()
+		re.transactions[hash] = recordedTransaction{Transaction: tx}
+		return tx, pending, nil
+	}
+
+	re.mu.Lock()
+	defer re.mu.Unlock()
+	recorded, ok := re.transactions[hash]
+	if !ok {
+		return nil, false, fmt.Errorf("transaction %s not recorded", hash.Hex())
+	}
+	return recorded.Transaction, false, nil
+}
+
+// TransactionSender returns the sender of tx.
+func (re *rangeEnrichment) TransactionSender(ctx context.Context, tx *types.Transaction, block common.Hash, index uint) (common.Address, error) {
+	if re.client != nil {
+		sender, err := re.client.TransactionSender(ctx, tx, block, index)
+		if err != nil {
+			return common.Address{}, err
+		}
+		re.mu.Lock()
+		defer re.mu.Unlock()
+		re.transactions[tx.Hash()] = recordedTransaction{Transaction: tx, Sender: sender}
+		return sender, nil
+	}
+
+	re.mu.Lock()
+	defer re.mu.Unlock()
+	recorded, ok := re.transactions[tx.Hash()]
+	if !ok {
+		return common.Address{}, fmt.Errorf("sender of transaction %s not recorded", tx.Hash().Hex())
+	}
+	return recorded.Sender, nil
+}
+
+// enrich completes the increments and submissions recorded since the last call with the enabled enrichments.
+func (nc *NonceCounter) enrich(ctx context.Context, enrichment *rangeEnrichment) {
+	if nc.blockTimestamps {
+		nc.stampIncrements(ctx, enrichment)
+	}
+	if nc.attributeSubmissions {
+		nc.fetchSubmissions(ctx, enrichment)
+	}
+}
+
+// recordLogs appends logs to the recording file as JSON lines, in the format eth_getLogs returns them.
+func (nc *NonceCounter) recordLogs(logs []types.Log) error {
+	lines := make([]any, len(logs))
+	for i := range logs {
+		lines[i] = logs[i]
+	}
+	return nc.record(lines...)
+}
+
+// recordRange appends the range marker of batch to the recording file, with what enrichment fetched for it.
+func (nc *NonceCounter) recordRange(batch Batch, enrichment *rangeEnrichment) error {
+	enrichment.mu.Lock()
+	defer enrichment.mu.Unlock()
+
+	return nc.record(recordedLine{Range: &recordedRange{
+		FromBlock:            batch.FromBlock,
+		ToBlock:              batch.ToBlock,
+		BlockTimestamps:      nc.blockTimestamps,
+		AttributeSubmissions: nc.attributeSubmissions,
+		Timestamps:           enrichment.timestamps,
+		Transactions:         enrichment.transactions,
+	}})
+}
+
+// record appends lines to the recording file as JSON lines.
+func (nc *NonceCounter) record(lines ...any) error {
+	if nc.recordPath == "" {
+		return nil
+	}
+
+	file, err := os.OpenFile(nc.recordPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open log recording: %w", err)
+	}
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, line := range lines {
+		if err := encoder.Encode(line); err != nil {
+			file.Close()
+			return fmt.Errorf("failed to record line: %w", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to record logs: %w", err)
+	}
+	return file.Close()
+}
+
+// ReplayFile runs Replay over the recorded logs in the file at path.
+func (nc *NonceCounter) ReplayFile(ctx context.Context, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open log recording: %w", err)
+	}
+	defer file.Close()
+
+	return nc.Replay(ctx, file)
+}
+
+// Replay counts the nonces of logs read from r instead of fetching them from an RPC, one JSON log per line as
+// eth_getLogs returns them and RecordPath records them. Logs must be in chain order, the ones that are not after the
+// previous log are skipped, so recordings of restarted runs can be replayed. The logs up to a range marker of a
+// recording are processed as that block range, like the recorded run processed them, so the replay prints the same
+// output. Logs without a range marker, such as eth_getLogs dumps, are processed a block at a time.
+// The block timestamps and transactions of a recording made with enrichments are replayed from its range markers, so
+// the counter has to enable the same enrichments, and can't enable any for logs without markers.
+func (nc *NonceCounter) Replay(ctx context.Context, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordedLogSize)
+
+	var pending []types.Log
+	// replayed is the end of the last block range processed, valid once ranged is set
+	var replayed uint64
+	var ranged bool
+	flushBlocks := func() error {
+		if len(pending) > 0 && (nc.blockTimestamps || nc.attributeSubmissions) {
+			return errors.New("logs without block range markers can't be replayed with block timestamps or submission attribution")
+		}
+		for len(pending) > 0 {
+			number := pending[0].BlockNumber
+			end := 1
+			for end < len(pending) && pending[end].BlockNumber == number {
+				end++
+			}
+			if err := nc.processBatch(ctx, Batch{FromBlock: number, ToBlock: number, Logs: end}, pending[:end], nil); err != nil {
+				return err
+			}
+			pending = pending[end:]
+		}
+		return nil
+	}
+
+	var previous *types.Log
+	for line := 1; scanner.Scan(); line++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var marker recordedLine
+		if err := json.Unmarshal(scanner.Bytes(), &marker); err != nil {
+			return fmt.Errorf("invalid log on line %d: %w", line, err)
+		}
+		if blockRange := marker.Range; blockRange != nil {
+			// A restarted run records the block ranges it rescans again
+			if ranged && blockRange.ToBlock <= replayed && len(pending) == 0 {
+				continue
+			}
+			if blockRange.BlockTimestamps != nc.blockTimestamps || blockRange.AttributeSubmissions != nc.attributeSubmissions {
+				return fmt.Errorf("block range on line %d was recorded with block timestamps %t and submission attribution %t, "+
+					"the replay has to enable the same", line, blockRange.BlockTimestamps, blockRange.AttributeSubmissions)
+			}
+			batch := Batch{FromBlock: blockRange.FromBlock, ToBlock: blockRange.ToBlock, Logs: len(pending)}
+			enrichment := &rangeEnrichment{timestamps: blockRange.Timestamps, transactions: blockRange.Transactions}
+			if err := nc.processBatch(ctx, batch, pending, func() error {
+				nc.enrich(ctx, enrichment)
+				return nil
+			}); err != nil {
+				return err
+			}
+			pending = nil
+			replayed, ranged = blockRange.ToBlock, true
+			continue
+		}
+
+		var vLog types.Log
+		if err := json.Unmarshal(scanner.Bytes(), &vLog); err != nil {
+			return fmt.Errorf("invalid log on line %d: %w", line, err)
+		}
+		if vLog.Removed {
+			continue
+		}
+		if previous != nil && (vLog.BlockNumber < previous.BlockNumber ||
+			vLog.BlockNumber == previous.BlockNumber && vLog.Index <= previous.Index) {
+			nc.Logger().Warn("skipping log, it is not after the previous log", "line", line, "block", vLog.BlockNumber,
+				"log_index", vLog.Index)
+			continue
+		}
+
+		pending = append(pending, vLog)
+		previous = &vLog
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read log recording: %w", err)
+	}
+	return flushBlocks()
+}
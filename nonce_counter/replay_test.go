@@ -0,0 +1,265 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// testContractAddress is the contract address of the counters built by the tests.
+const testContractAddress = "0x1234567890AbcdEF1234567890aBcdef12345678"
+
+// newTestConfig returns the configuration of a counter of the ValidatorAdded events of owners printing to out.
+func newTestConfig(out *bytes.Buffer, owners ...common.Address) Config {
+	config := Config{
+		Concurrency:     2,
+		ContractAddress: testContractAddress,
+		ContractABI:     SSVNetworkMetaData.ABI,
+		EventName:       "ValidatorAdded",
+		BlockBatchSize:  100,
+		Output:          out,
+	}
+	for _, owner := range owners {
+		config.Addresses = append(config.Addresses, owner.Hex())
+	}
+	return config
+}
+
+// newTestNonceCounter returns the counter of config.
+func newTestNonceCounter(t testing.TB, config Config) *NonceCounter {
+	t.Helper()
+
+	nc, err := NewNonceCounter(config)
+	if err != nil {
+		t.Fatalf("NewNonceCounter() error = %v", err)
+	}
+	return nc
+}
+
+// newReplayNonceCounter returns a counter of the ValidatorAdded events of owners printing to out.
+func newReplayNonceCounter(t testing.TB, out *bytes.Buffer, owners ...common.Address) *NonceCounter {
+	t.Helper()
+
+	return newTestNonceCounter(t, newTestConfig(out, owners...))
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	otherOwner := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+
+	var logs []types.Log
+	for i, spec := range []struct {
+		owner common.Address
+		block uint64
+	}{{owner, 10}, {otherOwner, 10}, {owner, 12}, {owner, 15}} {
+		vLog := newValidatorAddedLog(t, contractAbi, spec.owner)
+		vLog.BlockNumber = spec.block
+		vLog.BlockHash = common.BigToHash(common.Big2)
+		vLog.TxHash = common.BigToHash(common.Big3)
+		vLog.Index = uint(i)
+		logs = append(logs, vLog)
+	}
+
+	path := filepath.Join(t.TempDir(), "logs.jsonl")
+	service := &fakeEthService{head: 15, logs: logs}
+	rpcURL := newFakeRPC(t, service)
+	config := newTestConfig(&bytes.Buffer{}, owner, otherOwner)
+	config.BlockBatchSize = 3
+	config.RecordPath = path
+
+	// The live run fetches the ranges 5-8, which is empty, 9-12 and 13-15
+	var liveOut bytes.Buffer
+	config.Output = &liveOut
+	live := newTestNonceCounter(t, config)
+	if _, err := live.Sync(context.Background(), 5, rpcURL); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	// A restarted run rescanning the last range records it again
+	config.Output = &bytes.Buffer{}
+	if _, err := newTestNonceCounter(t, config).Sync(context.Background(), 13, rpcURL); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	recording, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read recording: %v", err)
+	}
+	if got := strings.Count(string(recording), `"blockRange"`); got != 4 {
+		t.Errorf("recording holds %d range markers, want 4:\n%s", got, recording)
+	}
+
+	want := strings.Join([]string{
+		"Address: " + owner.Hex() + ", Nonce: 0, Block: 10",
+		"Address: " + otherOwner.Hex() + ", Nonce: 0, Block: 10",
+		"Address: " + owner.Hex() + ", Nonce: 1, Block: 12",
+		"-----------------------------------------",
+		"Nonce for address modified, current state:",
+		"Address: " + owner.Hex() + ", Nonce: 2",
+		"Address: " + otherOwner.Hex() + ", Nonce: 1",
+		"-----------------------------------------",
+		"Address: " + owner.Hex() + ", Nonce: 2, Block: 15",
+		"-----------------------------------------",
+		"Nonce for address modified, current state:",
+		"Address: " + owner.Hex() + ", Nonce: 3",
+		"Address: " + otherOwner.Hex() + ", Nonce: 1",
+		"-----------------------------------------",
+	}, "\n") + "\n"
+	if liveOut.String() != want {
+		t.Fatalf("live output =\n%s\nwant\n%s", liveOut.String(), want)
+	}
+
+	for range 2 {
+		var out bytes.Buffer
+		var batches []Batch
+		nc := newReplayNonceCounter(t, &out, owner, otherOwner)
+		nc.OnBatch(func(batch Batch) {
+			batches = append(batches, batch)
+		})
+		if err := nc.ReplayFile(context.Background(), path); err != nil {
+			t.Fatalf("ReplayFile() error = %v", err)
+		}
+
+		if out.String() != liveOut.String() {
+			t.Errorf("Replay() output =\n%s\nwant the live output\n%s", out.String(), liveOut.String())
+		}
+		wantBatches := []Batch{{FromBlock: 5, ToBlock: 8}, {FromBlock: 9, ToBlock: 12, Logs: 3}, {FromBlock: 13, ToBlock: 15, Logs: 1}}
+		if !slices.Equal(batches, wantBatches) {
+			t.Errorf("replayed batches = %v, want %v", batches, wantBatches)
+		}
+		if got, want := nc.Nonces(), live.Nonces(); len(got) != 2 || got[owner] != want[owner] || got[otherOwner] != want[otherOwner] {
+			t.Errorf("Nonces() after replay = %v, want %v", got, want)
+		}
+		if block, ok := nc.LastBlock(); !ok || block != 15 {
+			t.Errorf("LastBlock() after replay = %d, %v, want 15, true", block, ok)
+		}
+	}
+}
+
+func TestReplayEnrichments(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	tx := newSignedTx(t, key, 0, common.HexToAddress(testContractAddress),
+		packRegistration(t, contractAbi, false, bytes.Repeat([]byte{0x01}, 48)))
+	vLog := newValidatorAddedLog(t, contractAbi, owner)
+	vLog.BlockNumber = 10
+	vLog.TxHash = tx.Hash()
+	batch := Batch{FromBlock: 5, ToBlock: 12, Logs: 1}
+
+	path := filepath.Join(t.TempDir(), "logs.jsonl")
+	newConfig := func(out *bytes.Buffer, enriched bool) Config {
+		config := newTestConfig(out, owner)
+		config.BlockTimestamps = enriched
+		config.AttributeSubmissions = enriched
+		return config
+	}
+
+	// The live run records what its enrichments fetched with the range marker
+	var liveOut bytes.Buffer
+	config := newConfig(&liveOut, true)
+	config.RecordPath = path
+	live := newTestNonceCounter(t, config)
+	enrichment := newRangeEnrichment(newBlockClock(&fakeChain{genesisTime: 1700000000, head: 100}),
+		fakeTransactionReader{txs: map[common.Hash]*types.Transaction{tx.Hash(): tx}})
+	if err := live.recordLogs([]types.Log{vLog}); err != nil {
+		t.Fatalf("recordLogs() error = %v", err)
+	}
+	if err := live.processBatch(context.Background(), batch, []types.Log{vLog}, func() error {
+		live.enrich(context.Background(), enrichment)
+		return live.recordRange(batch, enrichment)
+	}); err != nil {
+		t.Fatalf("processBatch() error = %v", err)
+	}
+
+	var out bytes.Buffer
+	nc := newTestNonceCounter(t, newConfig(&out, true))
+	if err := nc.ReplayFile(context.Background(), path); err != nil {
+		t.Fatalf("ReplayFile() error = %v", err)
+	}
+	if out.String() != liveOut.String() {
+		t.Errorf("Replay() output =\n%s\nwant the live output\n%s", out.String(), liveOut.String())
+	}
+	if got, want := nc.Increments(owner), live.Increments(owner); len(got) != 1 || got[0].Timestamp.IsZero() ||
+		!got[0].Timestamp.Equal(want[0].Timestamp) {
+		t.Errorf("Increments() after replay = %v, want %v", got, want)
+	}
+	if got, want := nc.Submissions(owner), live.Submissions(owner); len(got) != 1 || !got[0].Attributed ||
+		got[0].String() != want[0].String() {
+		t.Errorf("Submissions() after replay = %v, want %v", got, want)
+	}
+
+	// Replays enabling other enrichments than the recorded run would print something else
+	if err := newTestNonceCounter(t, newConfig(&bytes.Buffer{}, false)).ReplayFile(context.Background(), path); err == nil {
+		t.Errorf("ReplayFile() of an enriched recording without enrichments succeeded")
+	}
+	data, err := vLog.MarshalJSON()
+	if err != nil {
+		t.Fatalf("failed to encode log: %v", err)
+	}
+	if err := newTestNonceCounter(t, newConfig(&bytes.Buffer{}, true)).Replay(context.Background(),
+		bytes.NewReader(append(data, '\n'))); err == nil {
+		t.Errorf("Replay() of logs without range markers with enrichments succeeded")
+	}
+}
+
+func TestReplayGetLogsDump(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	vLog := newValidatorAddedLog(t, contractAbi, owner)
+	data, err := vLog.MarshalJSON()
+	if err != nil {
+		t.Fatalf("failed to encode log: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		dump      string
+		wantNonce uint64
+		wantErr   bool
+	}{
+		{
+			name:      "one log per line with blank lines",
+			dump:      string(data) + "\n\n",
+			wantNonce: 1,
+		},
+		{
+			name:      "removed logs are skipped",
+			dump:      strings.Replace(string(data), `"removed":false`, `"removed":true`, 1) + "\n",
+			wantNonce: 0,
+		},
+		{
+			name:    "invalid line",
+			dump:    string(data) + "\n{\"address\":\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			nc := newReplayNonceCounter(t, &out, owner)
+			err := nc.Replay(context.Background(), strings.NewReader(tt.dump))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Replay() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if nonce, _ := nc.NextNonce(owner); !tt.wantErr && nonce != tt.wantNonce {
+				t.Errorf("NextNonce() = %d, want %d", nonce, tt.wantNonce)
+			}
+		})
+	}
+
+	if err := newReplayNonceCounter(t, &bytes.Buffer{}, owner).ReplayFile(context.Background(),
+		filepath.Join(t.TempDir(), "missing.jsonl")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReplayFile() of a missing file error = %v", err)
+	}
+}
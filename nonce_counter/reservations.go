@@ -0,0 +1,210 @@
+package noncecounter
+
+import (
+	"cmp"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// defaultReservationTTL is the reservation lifetime used when Config.ReservationTTL is not set.
+const defaultReservationTTL = 15 * time.Minute
+
+var (
+	// ErrReservationNotFound is returned by Release for reservations that expired, were fulfilled or never existed.
+	ErrReservationNotFound = errors.New("reservation not found")
+	// ErrNotSynced is returned when reserving nonces before the counter caught up with the chain head once.
+	ErrNotSynced = errors.New("nonce counter has not caught up with the chain head yet")
+)
+
+// Reservation is a lease on a contiguous block of future nonces of an owner, handed out so concurrent keyshare
+// generation pipelines don't sign their shares with the same nonces.
+type Reservation struct {
+	ID         string         `json:"id"`
+	Owner      common.Address `json:"owner"`
+	FirstNonce uint64         `json:"firstNonce"`
+	Count      uint64         `json:"count"`
+	ExpiresAt  time.Time      `json:"expiresAt"`
+}
+
+// LastNonce returns the last nonce of the reservation.
+func (r Reservation) LastNonce() uint64 {
+	return r.FirstNonce + r.Count - 1
+}
+
+// Reserve leases the next n nonces of owner not yet used on chain or reserved by a live reservation. The reservation
+// lasts until it expires, is released, or ValidatorAdded events of owner consume all its nonces. Nonces can only be
+// reserved once the counter caught up with the chain head.
+func (nc *NonceCounter) Reserve(owner common.Address, n uint64) (Reservation, error) {
+	if n == 0 {
+		return Reservation{}, fmt.Errorf("at least one nonce must be reserved")
+	}
+
+	if !nc.synced.Load() {
+		return Reservation{}, ErrNotSynced
+	}
+
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	next, ok := nc.addressToNonce[owner.Hex()]
+	if !ok {
+		return Reservation{}, fmt.Errorf("address %s is not tracked", owner.Hex())
+	}
+
+	now := nc.now()
+	nc.dropExpiredReservations(now)
+	for _, reservation := range nc.reservations {
+		if reservation.Owner == owner && reservation.LastNonce() >= next {
+			next = reservation.LastNonce() + 1
+		}
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return Reservation{}, fmt.Errorf("failed to generate reservation ID: %w", err)
+	}
+	reservation := Reservation{
+		ID:         hex.EncodeToString(id),
+		Owner:      owner,
+		FirstNonce: next,
+		Count:      n,
+		ExpiresAt:  now.Add(nc.reservationTTL),
+	}
+
+	nc.reservations = append(nc.reservations, reservation)
+	if err := nc.saveReservations(); err != nil {
+		nc.reservations = nc.reservations[:len(nc.reservations)-1]
+		return Reservation{}, err
+	}
+	return reservation, nil
+}
+
+// Release gives the nonces of a reservation back before it expires.
+func (nc *NonceCounter) Release(id string) error {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	nc.dropExpiredReservations(nc.now())
+	i := slices.IndexFunc(nc.reservations, func(r Reservation) bool {
+		return r.ID == id
+	})
+	if i < 0 {
+		return fmt.Errorf("%w: %s", ErrReservationNotFound, id)
+	}
+
+	nc.reservations = slices.Delete(nc.reservations, i, i+1)
+	return nc.saveReservations()
+}
+
+// Reservations returns the live reservations of owner, sorted by first nonce.
+func (nc *NonceCounter) Reservations(owner common.Address) []Reservation {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	now := nc.now()
+	var reservations []Reservation
+	for _, reservation := range nc.reservations {
+		if reservation.Owner == owner && now.Before(reservation.ExpiresAt) {
+			reservations = append(reservations, reservation)
+		}
+	}
+	slices.SortFunc(reservations, func(a, b Reservation) int {
+		return cmp.Compare(a.FirstNonce, b.FirstNonce)
+	})
+	return reservations
+}
+
+// reconcileReservations drops the reservations of owner whose nonces were all consumed on chain.
+func (nc *NonceCounter) reconcileReservations(owner common.Address) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	if len(nc.reservations) == 0 {
+		return
+	}
+
+	next := nc.addressToNonce[owner.Hex()]
+	before := len(nc.reservations)
+	nc.reservations = slices.DeleteFunc(nc.reservations, func(r Reservation) bool {
+		return r.Owner == owner && r.LastNonce() < next
+	})
+	if len(nc.reservations) == before {
+		return
+	}
+	if err := nc.saveReservations(); err != nil {
+		nc.Logger().Error("failed to persist reservations", "error", err)
+	}
+}
+
+// dropExpiredReservations removes the reservations expired at now, the caller must hold the lock.
+// They are persisted along with the next change.
+func (nc *NonceCounter) dropExpiredReservations(now time.Time) {
+	nc.reservations = slices.DeleteFunc(nc.reservations, func(r Reservation) bool {
+		return !now.Before(r.ExpiresAt)
+	})
+}
+
+// loadReservations reads the reservations persisted at the configured path, ignoring a missing file.
+func (nc *NonceCounter) loadReservations() error {
+	if nc.reservationsPath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(nc.reservationsPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read reservations: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &nc.reservations); err != nil {
+		return fmt.Errorf("failed to decode reservations: %w", err)
+	}
+	nc.dropExpiredReservations(nc.now())
+	return nil
+}
+
+// saveReservations atomically persists the reservations to the configured path, the caller must hold the lock.
+func (nc *NonceCounter) saveReservations() error {
+	if nc.reservationsPath == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(nc.reservations, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode reservations: %w", err)
+	}
+
+	if err := writeFileAtomic(nc.reservationsPath, data); err != nil {
+		return fmt.Errorf("failed to persist reservations: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic replaces the file at path with data, so readers never see it partially written.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,198 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// newReservingNonceCounter returns a synced counter tracking owner at nonce, reading the time from now.
+func newReservingNonceCounter(t testing.TB, owner common.Address, nonce uint64, now *time.Time) *NonceCounter {
+	t.Helper()
+
+	config := newTestConfig(&bytes.Buffer{}, owner)
+	config.ReservationTTL = time.Minute
+	nc := newTestNonceCounter(t, config)
+	nc.now = func() time.Time { return *now }
+	if err := nc.restoreNonces(map[string]uint64{owner.Hex(): nonce}, 0, true); err != nil {
+		t.Fatalf("restoreNonces() error = %v", err)
+	}
+	nc.synced.Store(true)
+	return nc
+}
+
+func TestReserve(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	untracked := common.HexToAddress("0x9a8e8762CE71B669250e964d5262C390416aB3BA")
+
+	tests := []struct {
+		name      string
+		owner     common.Address
+		counts    []uint64
+		wantFirst []uint64
+		wantErr   bool
+	}{
+		{
+			name:      "single reservation starts at the next nonce",
+			owner:     owner,
+			counts:    []uint64{3},
+			wantFirst: []uint64{4},
+		},
+		{
+			name:      "reservations are contiguous",
+			owner:     owner,
+			counts:    []uint64{3, 1, 2},
+			wantFirst: []uint64{4, 7, 8},
+		},
+		{
+			name:    "zero nonces",
+			owner:   owner,
+			counts:  []uint64{0},
+			wantErr: true,
+		},
+		{
+			name:    "untracked owner",
+			owner:   untracked,
+			counts:  []uint64{1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Unix(1700000000, 0)
+			nc := newReservingNonceCounter(t, owner, 4, &now)
+
+			for i, n := range tt.counts {
+				reservation, err := nc.Reserve(tt.owner, n)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("Reserve() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if err != nil {
+					continue
+				}
+				if reservation.FirstNonce != tt.wantFirst[i] || reservation.Count != n {
+					t.Errorf("Reserve() = nonces %d+%d, want %d+%d", reservation.FirstNonce, reservation.Count, tt.wantFirst[i], n)
+				}
+				if !reservation.ExpiresAt.Equal(now.Add(time.Minute)) {
+					t.Errorf("Reserve() expires at %v, want %v", reservation.ExpiresAt, now.Add(time.Minute))
+				}
+			}
+			if got := len(nc.Reservations(tt.owner)); !tt.wantErr && got != len(tt.counts) {
+				t.Errorf("Reservations() = %d reservations, want %d", got, len(tt.counts))
+			}
+		})
+	}
+}
+
+func TestReserveNotSynced(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	now := time.Unix(1700000000, 0)
+	nc := newReservingNonceCounter(t, owner, 0, &now)
+	nc.synced.Store(false)
+
+	if _, err := nc.Reserve(owner, 1); !errors.Is(err, ErrNotSynced) {
+		t.Errorf("Reserve() error = %v, want %v", err, ErrNotSynced)
+	}
+}
+
+func TestReservationLifecycle(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	now := time.Unix(1700000000, 0)
+	nc := newReservingNonceCounter(t, owner, 0, &now)
+
+	first, err := nc.Reserve(owner, 2)
+	if err != nil {
+		t.Fatalf("Reserve() error = %v", err)
+	}
+	now = now.Add(30 * time.Second)
+	second, err := nc.Reserve(owner, 2)
+	if err != nil {
+		t.Fatalf("Reserve() error = %v", err)
+	}
+
+	// Registering the validators of the first reservation fulfills it
+	log := newValidatorAddedLog(t, nc.contractAbi, owner)
+	if _, err := nc.FindNonces(context.Background(), []types.Log{log, log}); err != nil {
+		t.Fatalf("FindNonces() error = %v", err)
+	}
+	if got := nc.Reservations(owner); len(got) != 1 || got[0].ID != second.ID {
+		t.Fatalf("Reservations() = %+v, want only %s", got, second.ID)
+	}
+	if err := nc.Release(first.ID); !errors.Is(err, ErrReservationNotFound) {
+		t.Errorf("Release() of a fulfilled reservation error = %v, want %v", err, ErrReservationNotFound)
+	}
+
+	// Once the second one expires its nonces are handed out again
+	now = now.Add(time.Minute)
+	if got := nc.Reservations(owner); len(got) != 0 {
+		t.Fatalf("Reservations() = %+v after expiry, want none", got)
+	}
+	third, err := nc.Reserve(owner, 1)
+	if err != nil {
+		t.Fatalf("Reserve() error = %v", err)
+	}
+	if third.FirstNonce != 2 {
+		t.Errorf("Reserve() after expiry first nonce = %d, want 2", third.FirstNonce)
+	}
+
+	if err := nc.Release(third.ID); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if got := nc.Reservations(owner); len(got) != 0 {
+		t.Errorf("Reservations() = %+v after release, want none", got)
+	}
+}
+
+func TestReservationsPersistence(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	path := filepath.Join(t.TempDir(), "reservations.json")
+	now := time.Unix(1700000000, 0)
+
+	nc := newReservingNonceCounter(t, owner, 3, &now)
+	nc.reservationsPath = path
+	reservation, err := nc.Reserve(owner, 2)
+	if err != nil {
+		t.Fatalf("Reserve() error = %v", err)
+	}
+
+	restarted := newReservingNonceCounter(t, owner, 3, &now)
+	restarted.reservationsPath = path
+	if err := restarted.loadReservations(); err != nil {
+		t.Fatalf("loadReservations() error = %v", err)
+	}
+	got := restarted.Reservations(owner)
+	if len(got) != 1 || got[0].ID != reservation.ID || got[0].FirstNonce != 3 || !got[0].ExpiresAt.Equal(reservation.ExpiresAt) {
+		t.Fatalf("Reservations() after reload = %+v, want %+v", got, reservation)
+	}
+	next, err := restarted.Reserve(owner, 1)
+	if err != nil {
+		t.Fatalf("Reserve() error = %v", err)
+	}
+	if next.FirstNonce != 5 {
+		t.Errorf("Reserve() after reload first nonce = %d, want 5", next.FirstNonce)
+	}
+
+	// Expired reservations are dropped on load
+	now = now.Add(time.Hour)
+	expired := newReservingNonceCounter(t, owner, 3, &now)
+	expired.reservationsPath = path
+	if err := expired.loadReservations(); err != nil {
+		t.Fatalf("loadReservations() error = %v", err)
+	}
+	if got := expired.Reservations(owner); len(got) != 0 {
+		t.Errorf("Reservations() = %+v, want expired ones dropped", got)
+	}
+
+	missing := newReservingNonceCounter(t, owner, 3, &now)
+	missing.reservationsPath = filepath.Join(t.TempDir(), "missing.json")
+	if err := missing.loadReservations(); err != nil {
+		t.Errorf("loadReservations() of a missing file error = %v", err)
+	}
+}
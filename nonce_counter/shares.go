@@ -0,0 +1,100 @@
+package noncecounter
+
+import (
+	"errors"
+	"fmt"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// shareSignatureLength is the length of the BLS signature over the owner and nonce heading the shares payload.
+	shareSignatureLength = 96
+	// sharePublicKeyLength is the length of the BLS public key share of every operator.
+	sharePublicKeyLength = 48
+	// shareEncryptedKeyLength is the length of the RSA encrypted private key share of every operator.
+	shareEncryptedKeyLength = 256
+)
+
+// blsSignatureDST is the domain separation tag of the Ethereum proof of possession BLS signature scheme.
+var blsSignatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// ErrShareSignatureMismatch is returned by VerifyShareSignature when the signature does not sign the owner and nonce.
+var ErrShareSignatureMismatch = errors.New("share signature does not match owner and nonce")
+
+// KeyShares is the shares payload of a validator registration split into its parts.
+type KeyShares struct {
+	// Signature is the BLS signature of the validator key over the owner address and nonce.
+	Signature []byte
+	// OperatorPublicKeys holds the BLS public key share of every operator, in operator order.
+	OperatorPublicKeys [][]byte
+	// EncryptedKeys holds the encrypted private key share of every operator, in operator order.
+	EncryptedKeys [][]byte
+}
+
+// ParseShares splits the shares payload of a validator registered with operatorCount operators.
+func ParseShares(shares []byte, operatorCount int) (KeyShares, error) {
+	if operatorCount <= 0 {
+		return KeyShares{}, fmt.Errorf("operator count must be greater than 0")
+	}
+
+	expected := shareSignatureLength + operatorCount*(sharePublicKeyLength+shareEncryptedKeyLength)
+	if len(shares) != expected {
+		return KeyShares{}, fmt.Errorf("shares for %d operators must be %d bytes long, got %d", operatorCount, expected, len(shares))
+	}
+
+	keyShares := KeyShares{
+		Signature:          shares[:shareSignatureLength],
+		OperatorPublicKeys: make([][]byte, 0, operatorCount),
+		EncryptedKeys:      make([][]byte, 0, operatorCount),
+	}
+	publicKeys := shares[shareSignatureLength : shareSignatureLength+operatorCount*sharePublicKeyLength]
+	encryptedKeys := shares[shareSignatureLength+operatorCount*sharePublicKeyLength:]
+	for i := 0; i < operatorCount; i++ {
+		keyShares.OperatorPublicKeys = append(keyShares.OperatorPublicKeys, publicKeys[i*sharePublicKeyLength:(i+1)*sharePublicKeyLength])
+		keyShares.EncryptedKeys = append(keyShares.EncryptedKeys, encryptedKeys[i*shareEncryptedKeyLength:(i+1)*shareEncryptedKeyLength])
+	}
+	return keyShares, nil
+}
+
+// ShareSignatureMessage returns the message the validator key signs in the shares payload, the keccak256 hash of
+// the checksummed owner address and the nonce joined by a colon, as the SSV nodes verify it.
+func ShareSignatureMessage(owner common.Address, nonce uint64) []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("%s:%d", owner.Hex(), nonce)))
+}
+
+// VerifyShareSignature checks that signature was made by the validator key publicKey over the owner and nonce.
+func VerifyShareSignature(signature, publicKey []byte, owner common.Address, nonce uint64) error {
+	var pk bls12381.G1Affine
+	if _, err := pk.SetBytes(publicKey); err != nil {
+		return fmt.Errorf("invalid validator public key: %w", err)
+	}
+	if pk.IsInfinity() {
+		return fmt.Errorf("invalid validator public key: point at infinity")
+	}
+
+	var sig bls12381.G2Affine
+	if _, err := sig.SetBytes(signature); err != nil {
+		return fmt.Errorf("invalid share signature: %w", err)
+	}
+
+	msg, err := bls12381.HashToG2(ShareSignatureMessage(owner, nonce), blsSignatureDST)
+	if err != nil {
+		return fmt.Errorf("failed to hash share signature message: %w", err)
+	}
+
+	// e(pk, H(m)) == e(g1, sig)
+	_, _, g1, _ := bls12381.Generators()
+	var negG1 bls12381.G1Affine
+	negG1.Neg(&g1)
+	ok, err := bls12381.PairingCheck([]bls12381.G1Affine{pk, negG1}, []bls12381.G2Affine{msg, sig})
+	if err != nil {
+		return fmt.Errorf("failed to verify share signature: %w", err)
+	}
+	if !ok {
+		return ErrShareSignatureMismatch
+	}
+	return nil
+}
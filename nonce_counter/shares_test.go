@@ -0,0 +1,151 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// signShares builds the validator public key of secretKey and a shares payload for operatorCount operators signed
+// over owner and nonce, as ssv-keys generates them.
+func signShares(t testing.TB, secretKey int64, owner common.Address, nonce uint64, operatorCount int) ([]byte, []byte) {
+	t.Helper()
+
+	sk := big.NewInt(secretKey)
+	var pk bls12381.G1Affine
+	pk.ScalarMultiplicationBase(sk)
+
+	msg, err := bls12381.HashToG2(ShareSignatureMessage(owner, nonce), blsSignatureDST)
+	if err != nil {
+		t.Fatalf("failed to hash message: %v", err)
+	}
+	var sig bls12381.G2Affine
+	sig.ScalarMultiplication(&msg, sk)
+
+	pkBytes, sigBytes := pk.Bytes(), sig.Bytes()
+	shares := append([]byte{}, sigBytes[:]...)
+	shares = append(shares, bytes.Repeat([]byte{0x01}, operatorCount*sharePublicKeyLength)...)
+	shares = append(shares, bytes.Repeat([]byte{0x02}, operatorCount*shareEncryptedKeyLength)...)
+	return pkBytes[:], shares
+}
+
+func TestParseShares(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	_, shares := signShares(t, 42, owner, 0, 4)
+
+	tests := []struct {
+		name          string
+		shares        []byte
+		operatorCount int
+		wantErr       bool
+	}{
+		{name: "valid shares", shares: shares, operatorCount: 4},
+		{name: "wrong operator count", shares: shares, operatorCount: 7, wantErr: true},
+		{name: "truncated shares", shares: shares[:len(shares)-1], operatorCount: 4, wantErr: true},
+		{name: "no operators", shares: shares[:shareSignatureLength], operatorCount: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyShares, err := ParseShares(tt.shares, tt.operatorCount)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseShares() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !bytes.Equal(keyShares.Signature, tt.shares[:shareSignatureLength]) {
+				t.Errorf("Signature = %x, want %x", keyShares.Signature, tt.shares[:shareSignatureLength])
+			}
+			if len(keyShares.OperatorPublicKeys) != tt.operatorCount || len(keyShares.EncryptedKeys) != tt.operatorCount {
+				t.Fatalf("got %d public keys and %d encrypted keys, want %d", len(keyShares.OperatorPublicKeys), len(keyShares.EncryptedKeys), tt.operatorCount)
+			}
+			if !bytes.Equal(keyShares.OperatorPublicKeys[3], bytes.Repeat([]byte{0x01}, sharePublicKeyLength)) {
+				t.Errorf("OperatorPublicKeys[3] = %x", keyShares.OperatorPublicKeys[3])
+			}
+			if !bytes.Equal(keyShares.EncryptedKeys[3], bytes.Repeat([]byte{0x02}, shareEncryptedKeyLength)) {
+				t.Errorf("EncryptedKeys[3] = %x", keyShares.EncryptedKeys[3])
+			}
+		})
+	}
+}
+
+func TestVerifyShareSignature(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	publicKey, shares := signShares(t, 42, owner, 3, 4)
+	otherPublicKey, _ := signShares(t, 43, owner, 3, 4)
+	signature := shares[:shareSignatureLength]
+
+	tests := []struct {
+		name         string
+		publicKey    []byte
+		signature    []byte
+		owner        common.Address
+		nonce        uint64
+		wantErr      bool
+		wantMismatch bool
+	}{
+		{name: "valid signature", publicKey: publicKey, signature: signature, owner: owner, nonce: 3},
+		{name: "wrong nonce", publicKey: publicKey, signature: signature, owner: owner, nonce: 4, wantErr: true, wantMismatch: true},
+		{name: "wrong owner", publicKey: publicKey, signature: signature, owner: common.Address{}, nonce: 3, wantErr: true, wantMismatch: true},
+		{name: "wrong validator", publicKey: otherPublicKey, signature: signature, owner: owner, nonce: 3, wantErr: true, wantMismatch: true},
+		{name: "malformed public key", publicKey: publicKey[:10], signature: signature, owner: owner, nonce: 3, wantErr: true},
+		{name: "malformed signature", publicKey: publicKey, signature: bytes.Repeat([]byte{0xff}, shareSignatureLength), owner: owner, nonce: 3, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyShareSignature(tt.signature, tt.publicKey, tt.owner, tt.nonce)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyShareSignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrShareSignatureMismatch) != tt.wantMismatch {
+				t.Errorf("VerifyShareSignature() error = %v, wantMismatch %v", err, tt.wantMismatch)
+			}
+		})
+	}
+}
+
+func TestFindNoncesVerifyShares(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	operators := []uint64{1, 2, 3, 4}
+	cluster := ISSVNetworkCoreCluster{ValidatorCount: 1, Balance: big.NewInt(0)}
+
+	registration := func(secretKey int64, nonce uint64) types.Log {
+		publicKey, shares := signShares(t, secretKey, owner, nonce, len(operators))
+		return newEventLog(t, contractAbi, "ValidatorAdded", []common.Hash{common.BytesToHash(owner.Bytes())},
+			operators, publicKey, shares, cluster)
+	}
+
+	nc := &NonceCounter{
+		eventName:      "ValidatorAdded",
+		addresses:      []string{owner.Hex()},
+		addressToNonce: map[string]uint64{owner.Hex(): 0},
+		concurrency:    2,
+		verifyShares:   true,
+		registry:       NewRegistry(contractAbi),
+	}
+	if err := Handle(nc.registry, nc.eventName, nc.handleValidatorAdded); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	// The third registration was signed over a nonce the owner had already used
+	logs := []types.Log{registration(1, 0), registration(2, 1), registration(3, 1), registration(4, 3)}
+	if _, err := nc.FindNonces(context.Background(), logs); err != nil {
+		t.Fatalf("FindNonces() error = %v", err)
+	}
+
+	if got := nc.ShareMismatches(); got != 1 {
+		t.Errorf("ShareMismatches() = %d, want 1", got)
+	}
+	if got := nc.addressToNonce[owner.Hex()]; got != 4 {
+		t.Errorf("nonce = %d, want 4", got)
+	}
+}
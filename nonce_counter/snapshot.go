@@ -0,0 +1,259 @@
+package noncecounter
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// snapshotVersion is the version of the snapshot format written by TakeSnapshot. Version 2 added Complete.
+const snapshotVersion = 2
+
+// ErrSnapshotNotCanonical is returned by Start and Sync when the block a snapshot was taken at is no longer part of
+// the canonical chain, as it was reorganized away.
+var ErrSnapshotNotCanonical = errors.New("snapshot block is not canonical")
+
+// ErrIncompleteNonces is returned by TakeSnapshot when the nonces miss events since the contract deployment, as the
+// scan started after DeploymentBlock or resumed from incomplete nonces.
+var ErrIncompleteNonces = errors.New("nonces do not cover every event since the contract deployment")
+
+// headerReader is the part of the Ethereum client needed to check snapshot blocks.
+type headerReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Snapshot is the nonce state of a set of owners at a block, used to bootstrap a counter without rescanning the
+// chain from the contract deployment.
+type Snapshot struct {
+	Version         int            `json:"version"`
+	ContractAddress common.Address `json:"contractAddress"`
+	Block           uint64         `json:"block"`
+	BlockHash       common.Hash    `json:"blockHash"`
+	// Nonces are the next nonces of the owners after Block was processed, sorted by owner.
+	Nonces []SnapshotNonce `json:"nonces"`
+	// Complete is set when the nonces account for every event since the contract deployment, a counter bootstrapped
+	// from an incomplete snapshot is not Complete either.
+	Complete bool `json:"complete"`
+	// Digest is the keccak256 hash of the fields above. It only detects corruption, anyone editing the snapshot can
+	// recompute it, so only the signature of a required signer guards against tampering.
+	Digest common.Hash `json:"digest"`
+	// Signer and Signature are set on snapshots signed by the holder of a key, Signature signs Digest.
+	Signer    *common.Address `json:"signer,omitempty"`
+	Signature hexutil.Bytes   `json:"signature,omitempty"`
+}
+
+// SnapshotNonce is the next nonce of an owner in a snapshot.
+type SnapshotNonce struct {
+	Owner     common.Address `json:"owner"`
+	NextNonce uint64         `json:"nextNonce"`
+}
+
+// TakeSnapshot returns the nonces of every tracked owner at the last processed block, fetching its hash through the
+// RPC. The counter must not be scanning, so call it after Sync returns or from an OnBatch hook. It returns
+// ErrIncompleteNonces unless the nonces are Complete, a snapshot of partial counts would bootstrap wrong nonces.
+func (nc *NonceCounter) TakeSnapshot(ctx context.Context, rpcURL string) (Snapshot, error) {
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	defer client.Close()
+
+	return nc.takeSnapshot(ctx, client)
+}
+
+// takeSnapshot returns the nonces of every tracked owner at the last processed block.
+func (nc *NonceCounter) takeSnapshot(ctx context.Context, client headerReader) (Snapshot, error) {
+	block, ok := nc.LastBlock()
+	if !ok {
+		return Snapshot{}, fmt.Errorf("no block was processed yet")
+	}
+	if !nc.Complete() {
+		return Snapshot{}, ErrIncompleteNonces
+	}
+	header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(block))
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("failed to fetch header of block %d: %w", block, err)
+	}
+
+	snapshot := Snapshot{
+		Version:         snapshotVersion,
+		ContractAddress: common.HexToAddress(nc.contractAddress),
+		Block:           block,
+		BlockHash:       header.Hash(),
+		Complete:        true,
+	}
+	for owner, nonce := range nc.Nonces() {
+		snapshot.Nonces = append(snapshot.Nonces, SnapshotNonce{Owner: owner, NextNonce: nonce})
+	}
+	slices.SortFunc(snapshot.Nonces, func(a, b SnapshotNonce) int {
+		return a.Owner.Cmp(b.Owner)
+	})
+	snapshot.Digest, err = snapshot.digest()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// Sign signs the snapshot digest with key, recording the key's address as the signer.
+func (s *Snapshot) Sign(key *ecdsa.PrivateKey) error {
+	signature, err := crypto.Sign(s.Digest.Bytes(), key)
+	if err != nil {
+		return fmt.Errorf("failed to sign snapshot: %w", err)
+	}
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+	s.Signer = &signer
+	s.Signature = signature
+	return nil
+}
+
+// Verify checks that the digest matches the snapshot content and that the signature, if any, was made by the
+// recorded signer. When signer is not nil the snapshot must be signed by it.
+func (s Snapshot) Verify(signer *common.Address) error {
+	if s.Version != snapshotVersion {
+		return fmt.Errorf("unsupported snapshot version %d", s.Version)
+	}
+	digest, err := s.digest()
+	if err != nil {
+		return err
+	}
+	if digest != s.Digest {
+		return fmt.Errorf("snapshot digest %s does not match its content, expected %s", s.Digest.Hex(), digest.Hex())
+	}
+
+	if s.Signature == nil {
+		if signer != nil {
+			return fmt.Errorf("snapshot is not signed, expected a signature by %s", signer.Hex())
+		}
+		return nil
+	}
+	if s.Signer == nil {
+		return fmt.Errorf("snapshot is signed but names no signer")
+	}
+	publicKey, err := crypto.SigToPub(s.Digest.Bytes(), s.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid snapshot signature: %w", err)
+	}
+	if recovered := crypto.PubkeyToAddress(*publicKey); recovered != *s.Signer {
+		return fmt.Errorf("snapshot signature was made by %s, not by its signer %s", recovered.Hex(), s.Signer.Hex())
+	}
+	if signer != nil && *s.Signer != *signer {
+		return fmt.Errorf("snapshot is signed by %s, expected %s", s.Signer.Hex(), signer.Hex())
+	}
+	return nil
+}
+
+// digest returns the keccak256 hash of the JSON encoding of the snapshot content.
+func (s Snapshot) digest() (common.Hash, error) {
+	content := struct {
+		Version         int             `json:"version"`
+		ContractAddress common.Address  `json:"contractAddress"`
+		Block           uint64          `json:"block"`
+		BlockHash       common.Hash     `json:"blockHash"`
+		Nonces          []SnapshotNonce `json:"nonces"`
+		Complete        bool            `json:"complete"`
+	}{s.Version, s.ContractAddress, s.Block, s.BlockHash, s.Nonces, s.Complete}
+	data, err := json.Marshal(content)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	return crypto.Keccak256Hash(data), nil
+}
+
+// WriteSnapshot writes the snapshot as JSON to the file at path.
+func WriteSnapshot(path string, snapshot Snapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := writeFileAtomic(path, data); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// LoadSnapshot reads the snapshot file at path. It has to be verified before use.
+func LoadSnapshot(path string) (Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return Snapshot{}, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+// applySnapshot verifies the snapshot and sets the nonces of the tracked owners from it, every one of which must be
+// in the snapshot. Scanning then resumes after the snapshot block once it is found canonical.
+func (nc *NonceCounter) applySnapshot(snapshot Snapshot, signer *common.Address) error {
+	if err := snapshot.Verify(signer); err != nil {
+		return err
+	}
+	if contract := common.HexToAddress(nc.contractAddress); snapshot.ContractAddress != contract {
+		return fmt.Errorf("snapshot was taken for contract %s, not %s", snapshot.ContractAddress.Hex(), contract.Hex())
+	}
+
+	nonces := make(map[string]uint64, len(snapshot.Nonces))
+	for _, nonce := range snapshot.Nonces {
+		nonces[nonce.Owner.Hex()] = nonce.NextNonce
+	}
+	if err := nc.restoreNonces(nonces, snapshot.Block, snapshot.Complete); err != nil {
+		return fmt.Errorf("snapshot at block %d: %w", snapshot.Block, err)
+	}
+
+	nc.snapshot = &snapshot
+	return nil
+}
+
+// restoreNonces sets the nonces of the tracked owners to the ones they had once block was processed, every tracked
+// owner must be in nonces, which is keyed by checksummed address. complete tells whether the restored nonces account for
+// every event since the contract deployment.
+func (nc *NonceCounter) restoreNonces(nonces map[string]uint64, block uint64, complete bool) error {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	for address := range nc.addressToNonce {
+		if _, ok := nonces[address]; !ok {
+			return fmt.Errorf("no nonce for %s", address)
+		}
+	}
+	for address := range nc.addressToNonce {
+		nc.addressToNonce[address] = nonces[address]
+	}
+	nc.lastBlock.Store(block)
+	nc.processed.Store(true)
+	nc.complete.Store(complete)
+	return nil
+}
+
+// resumeFromSnapshot checks that the block of the applied snapshot is still canonical and returns the block to resume
+// scanning from, startBlock when no snapshot was applied or it is past the snapshot block.
+func (nc *NonceCounter) resumeFromSnapshot(ctx context.Context, client headerReader, startBlock uint64) (uint64, error) {
+	if nc.snapshot == nil {
+		return startBlock, nil
+	}
+
+	header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(nc.snapshot.Block))
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch header of snapshot block %d: %w", nc.snapshot.Block, err)
+	}
+	if header.Hash() != nc.snapshot.BlockHash {
+		return 0, fmt.Errorf("%w: block %d is %s, snapshot has %s", ErrSnapshotNotCanonical, nc.snapshot.Block,
+			header.Hash().Hex(), nc.snapshot.BlockHash.Hex())
+	}
+	return max(startBlock, nc.snapshot.Block+1), nil
+}
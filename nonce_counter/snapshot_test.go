@@ -0,0 +1,311 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// fakeHeaderReader serves headers whose hash is derived from the block number and fork.
+type fakeHeaderReader struct {
+	fork int64
+}
+
+func (f fakeHeaderReader) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).Set(number), Difficulty: big.NewInt(f.fork)}, nil
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	contract := common.HexToAddress("0x38A4794cCEd47d3baf7370CcC43B560D3a1beEFA")
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	otherOwner := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+	key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+	chain := fakeHeaderReader{fork: 1}
+
+	source := &NonceCounter{
+		contractAddress: contract.Hex(),
+		addressToNonce:  map[string]uint64{owner.Hex(): 7, otherOwner.Hex(): 2},
+	}
+	if _, err := source.takeSnapshot(context.Background(), chain); err == nil {
+		t.Fatalf("takeSnapshot() before processing a block succeeded")
+	}
+	source.lastBlock.Store(500)
+	source.processed.Store(true)
+	if _, err := source.takeSnapshot(context.Background(), chain); !errors.Is(err, ErrIncompleteNonces) {
+		t.Fatalf("takeSnapshot() of nonces not counted from the deployment error = %v, want %v", err, ErrIncompleteNonces)
+	}
+	source.complete.Store(true)
+
+	snapshot, err := source.takeSnapshot(context.Background(), chain)
+	if err != nil {
+		t.Fatalf("takeSnapshot() error = %v", err)
+	}
+	header, _ := chain.HeaderByNumber(context.Background(), big.NewInt(500))
+	if snapshot.Block != 500 || snapshot.BlockHash != header.Hash() || len(snapshot.Nonces) != 2 ||
+		snapshot.Nonces[0].Owner != otherOwner || snapshot.Nonces[1].NextNonce != 7 {
+		t.Fatalf("takeSnapshot() = %+v", snapshot)
+	}
+	if err := snapshot.Sign(key); err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	if err := WriteSnapshot(path, snapshot); err != nil {
+		t.Fatalf("WriteSnapshot() error = %v", err)
+	}
+	loaded, err := LoadSnapshot(path)
+	if err != nil {
+		t.Fatalf("LoadSnapshot() error = %v", err)
+	}
+
+	// A counter tracking a subset of the owners resumes after the snapshot block
+	nc := &NonceCounter{
+		contractAddress: contract.Hex(),
+		addressToNonce:  map[string]uint64{owner.Hex(): 0},
+	}
+	if err := nc.applySnapshot(loaded, &signer); err != nil {
+		t.Fatalf("applySnapshot() error = %v", err)
+	}
+	if nonce, _ := nc.NextNonce(owner); nonce != 7 {
+		t.Errorf("NextNonce() = %d, want 7", nonce)
+	}
+	if block, ok := nc.LastBlock(); !ok || block != 500 {
+		t.Errorf("LastBlock() = %d, %v, want 500, true", block, ok)
+	}
+
+	for _, tt := range []struct {
+		startBlock uint64
+		want       uint64
+	}{{0, 501}, {501, 501}, {800, 800}} {
+		got, err := nc.resumeFromSnapshot(context.Background(), chain, tt.startBlock)
+		if err != nil || got != tt.want {
+			t.Errorf("resumeFromSnapshot(%d) = %d, %v, want %d", tt.startBlock, got, err, tt.want)
+		}
+	}
+	if _, err := nc.resumeFromSnapshot(context.Background(), fakeHeaderReader{fork: 2}, 0); !errors.Is(err, ErrSnapshotNotCanonical) {
+		t.Errorf("resumeFromSnapshot() after a reorg error = %v, want %v", err, ErrSnapshotNotCanonical)
+	}
+}
+
+func TestSnapshotRequiresCompleteNonces(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	vLog := newValidatorAddedLog(t, contractAbi, owner)
+	vLog.BlockNumber = 10
+	rpcURL := newFakeRPC(t, &fakeEthService{head: 15, logs: []types.Log{vLog}})
+
+	tests := []struct {
+		name       string
+		startBlock uint64
+		resumeFrom uint64
+		wantErr    error
+	}{
+		{name: "from the deployment", startBlock: 5},
+		{name: "before the deployment", startBlock: 0},
+		{name: "after the deployment", startBlock: 12, wantErr: ErrIncompleteNonces},
+		{name: "from a checkpoint", startBlock: 12, resumeFrom: 11},
+		{name: "past a checkpoint", startBlock: 13, resumeFrom: 11, wantErr: ErrIncompleteNonces},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := newTestConfig(&bytes.Buffer{}, owner)
+			config.DeploymentBlock = 5
+			nc := newTestNonceCounter(t, config)
+			if tt.resumeFrom != 0 {
+				if err := nc.restoreNonces(map[string]uint64{owner.Hex(): 1}, tt.resumeFrom, true); err != nil {
+					t.Fatalf("restoreNonces() error = %v", err)
+				}
+			}
+			if _, err := nc.Sync(context.Background(), tt.startBlock, rpcURL); err != nil {
+				t.Fatalf("Sync() error = %v", err)
+			}
+
+			_, err := nc.TakeSnapshot(context.Background(), rpcURL)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("TakeSnapshot() error = %v, want %v", err, tt.wantErr)
+			}
+			if nc.Complete() != (tt.wantErr == nil) {
+				t.Errorf("Complete() = %v, want %v", nc.Complete(), tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+func TestSnapshotVerify(t *testing.T) {
+	key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	otherKey, _ := crypto.HexToECDSA("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a")
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+
+	newSnapshot := func(t *testing.T) Snapshot {
+		t.Helper()
+		snapshot := Snapshot{
+			Version:         snapshotVersion,
+			ContractAddress: common.HexToAddress("0x38A4794cCEd47d3baf7370CcC43B560D3a1beEFA"),
+			Block:           500,
+			BlockHash:       common.HexToHash("0x01"),
+			Nonces:          []SnapshotNonce{{Owner: signer, NextNonce: 3}},
+		}
+		digest, err := snapshot.digest()
+		if err != nil {
+			t.Fatalf("digest() error = %v", err)
+		}
+		snapshot.Digest = digest
+		return snapshot
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(t *testing.T, s *Snapshot)
+		signer  *common.Address
+		wantErr bool
+	}{
+		{
+			name: "hashed",
+		},
+		{
+			name:    "hashed but a signature is required",
+			signer:  &signer,
+			wantErr: true,
+		},
+		{
+			name: "signed by the required signer",
+			modify: func(t *testing.T, s *Snapshot) {
+				if err := s.Sign(key); err != nil {
+					t.Fatalf("Sign() error = %v", err)
+				}
+			},
+			signer: &signer,
+		},
+		{
+			name: "signed by another signer",
+			modify: func(t *testing.T, s *Snapshot) {
+				if err := s.Sign(otherKey); err != nil {
+					t.Fatalf("Sign() error = %v", err)
+				}
+			},
+			signer:  &signer,
+			wantErr: true,
+		},
+		{
+			name: "signer replaced",
+			modify: func(t *testing.T, s *Snapshot) {
+				if err := s.Sign(otherKey); err != nil {
+					t.Fatalf("Sign() error = %v", err)
+				}
+				s.Signer = &signer
+			},
+			wantErr: true,
+		},
+		{
+			name: "nonce tampered with",
+			modify: func(t *testing.T, s *Snapshot) {
+				s.Nonces[0].NextNonce = 10
+			},
+			wantErr: true,
+		},
+		{
+			name: "claimed complete after hashing",
+			modify: func(t *testing.T, s *Snapshot) {
+				s.Complete = true
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported version",
+			modify: func(t *testing.T, s *Snapshot) {
+				s.Version = snapshotVersion + 1
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := newSnapshot(t)
+			if tt.modify != nil {
+				tt.modify(t, &snapshot)
+			}
+			if err := snapshot.Verify(tt.signer); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApplySnapshot(t *testing.T) {
+	contract := common.HexToAddress("0x38A4794cCEd47d3baf7370CcC43B560D3a1beEFA")
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	untracked := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+
+	newSnapshot := func(contract common.Address, complete bool, owners ...common.Address) Snapshot {
+		snapshot := Snapshot{Version: snapshotVersion, ContractAddress: contract, Block: 10, Complete: complete}
+		for _, owner := range owners {
+			snapshot.Nonces = append(snapshot.Nonces, SnapshotNonce{Owner: owner, NextNonce: 4})
+		}
+		snapshot.Digest, _ = snapshot.digest()
+		return snapshot
+	}
+
+	tests := []struct {
+		name         string
+		snapshot     Snapshot
+		wantErr      bool
+		wantComplete bool
+	}{
+		{
+			name:         "every tracked owner is in the snapshot",
+			snapshot:     newSnapshot(contract, true, owner, untracked),
+			wantComplete: true,
+		},
+		{
+			name:     "incomplete snapshot",
+			snapshot: newSnapshot(contract, false, owner),
+		},
+		{
+			name:     "tracked owner missing",
+			snapshot: newSnapshot(contract, true, untracked),
+			wantErr:  true,
+		},
+		{
+			name:     "other contract",
+			snapshot: newSnapshot(common.HexToAddress("0x01"), true, owner),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nc := &NonceCounter{
+				contractAddress: contract.Hex(),
+				addressToNonce:  map[string]uint64{owner.Hex(): 0},
+			}
+			err := nc.applySnapshot(tt.snapshot, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applySnapshot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			want := uint64(4)
+			if tt.wantErr {
+				want = 0
+			}
+			if nonce, _ := nc.NextNonce(owner); nonce != want {
+				t.Errorf("NextNonce() = %d, want %d", nonce, want)
+			}
+			if _, ok := nc.NextNonce(untracked); ok {
+				t.Errorf("applySnapshot() started tracking an owner that was not configured")
+			}
+			if nc.Complete() != tt.wantComplete {
+				t.Errorf("Complete() = %v, want %v", nc.Complete(), tt.wantComplete)
+			}
+		})
+	}
+}
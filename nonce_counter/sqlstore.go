@@ -0,0 +1,279 @@
+package noncecounter
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	// Pure Go SQLite driver, registered as "sqlite"
+	_ "modernc.org/sqlite"
+)
+
+// sqlMigrations are the schema changes of the SQL store, applied in order. Each one is applied once and recorded in
+// schema_migrations under its 1-based version, so they must never be edited or reordered, only appended to.
+var sqlMigrations = []string{
+	// The uint64 cluster indexes overflow INTEGER columns, they are stored as decimal strings like the balance
+	`CREATE TABLE validator_added (
+		block_number INTEGER NOT NULL,
+		log_index INTEGER NOT NULL,
+		block_hash TEXT NOT NULL,
+		tx_hash TEXT NOT NULL,
+		owner TEXT NOT NULL,
+		public_key TEXT NOT NULL,
+		operator_ids TEXT NOT NULL,
+		shares TEXT NOT NULL,
+		cluster_validator_count INTEGER NOT NULL,
+		cluster_network_fee_index TEXT NOT NULL,
+		cluster_index TEXT NOT NULL,
+		cluster_active INTEGER NOT NULL,
+		cluster_balance TEXT NOT NULL,
+		PRIMARY KEY (block_number, log_index)
+	);
+	CREATE INDEX validator_added_owner ON validator_added (owner);
+	CREATE INDEX validator_added_public_key ON validator_added (public_key);
+	CREATE TABLE nonces (
+		owner TEXT PRIMARY KEY,
+		next_nonce INTEGER NOT NULL,
+		block_number INTEGER NOT NULL
+	);
+	CREATE TABLE checkpoint (
+		id INTEGER PRIMARY KEY CHECK (id = 1),
+		block_number INTEGER NOT NULL,
+		complete INTEGER NOT NULL,
+		updated_at TEXT NOT NULL
+	);`,
+}
+
+// SQLStore is a SQLite database holding every ValidatorAdded event of the contract and the nonces of the tracked
+// owners, for SQL queries over the indexed data. Every processed block range is written in a single transaction along
+// with the scan checkpoint, so the database always reflects a whole number of ranges.
+type SQLStore struct {
+	db *sql.DB
+	// pending holds the events of the block range being processed, and of earlier ones whose write failed
+	mu      sync.Mutex
+	pending []sqlValidatorAdded
+}
+
+// sqlValidatorAdded is a ValidatorAdded event waiting to be written.
+type sqlValidatorAdded struct {
+	event SSVNetworkValidatorAdded
+	log   types.Log
+}
+
+// OpenSQLStore opens the SQLite database at path, creating it if needed, and migrates it to the latest schema.
+func OpenSQLStore(path string) (*SQLStore, error) {
+	dsn := "file:" + (&url.URL{Path: path}).EscapedPath() + "?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SQL store: %w", err)
+	}
+	// SQLite allows a single writer, serializing on one connection avoids busy errors
+	db.SetMaxOpenConns(1)
+
+	store := &SQLStore{db: db}
+	if err := store.migrate(context.Background()); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+// DB returns the underlying database, for queries.
+func (s *SQLStore) DB() *sql.DB {
+	return s.db
+}
+
+// Close closes the database.
+func (s *SQLStore) Close() error {
+	return s.db.Close()
+}
+
+// SchemaVersion returns the number of migrations applied to the database.
+func (s *SQLStore) SchemaVersion(ctx context.Context) (int, error) {
+	var version int
+	if err := s.db.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return version, nil
+}
+
+// Checkpoint returns the last block written to the store, and false if none was.
+func (s *SQLStore) Checkpoint(ctx context.Context) (uint64, bool, error) {
+	var block uint64
+	err := s.db.QueryRowContext(ctx, "SELECT block_number FROM checkpoint WHERE id = 1").Scan(&block)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+	return block, true, nil
+}
+
+// Attach makes the store record the events and nonces of nc after every block range it processes, once the block range
+// was delivered to every OnDeliver hook of nc, so its checkpoint never passes undelivered events. When the store
+// holds a checkpoint, the nonces of nc are restored from it and the block to resume scanning from is returned along
+// with true. Every owner nc tracks must then be in the store. It must be called before Start.
+func (s *SQLStore) Attach(nc *NonceCounter) (uint64, bool, error) {
+	ctx := context.Background()
+	block, ok, err := s.Checkpoint(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	if ok {
+		if err := s.restore(ctx, nc, block); err != nil {
+			return 0, false, err
+		}
+	}
+
+	if err := Handle(nc.Registry(), "ValidatorAdded", func(e *SSVNetworkValidatorAdded, vLog types.Log) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.pending = append(s.pending, sqlValidatorAdded{event: *e, log: vLog})
+	}); err != nil {
+		return 0, false, err
+	}
+	nc.OnCheckpoint(func(batch Batch) {
+		if err := s.writeBatch(ctx, nc, batch); err != nil {
+			// The events stay pending and are written along with the next block range
+			nc.Logger().Error("failed to write block range to SQL store, retrying with the next one",
+				"from_block", batch.FromBlock, "to_block", batch.ToBlock, "error", err)
+		}
+	})
+
+	if !ok {
+		return 0, false, nil
+	}
+	return block + 1, true, nil
+}
+
+// restore sets the nonces of nc to the ones stored at the checkpoint block, complete when the scan that wrote them was.
+func (s *SQLStore) restore(ctx context.Context, nc *NonceCounter, block uint64) error {
+	rows, err := s.db.QueryContext(ctx, "SELECT owner, next_nonce FROM nonces")
+	if err != nil {
+		return fmt.Errorf("failed to read nonces: %w", err)
+	}
+	defer rows.Close()
+
+	nonces := map[string]uint64{}
+	for rows.Next() {
+		var owner string
+		var nonce uint64
+		if err := rows.Scan(&owner, &nonce); err != nil {
+			return fmt.Errorf("failed to read nonces: %w", err)
+		}
+		nonces[normalizeOwner(owner)] = nonce
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read nonces: %w", err)
+	}
+
+	// Nonces written by a scan that started after the deployment stay incomplete once restored
+	var complete bool
+	if err := s.db.QueryRowContext(ctx, "SELECT complete FROM checkpoint WHERE id = 1").Scan(&complete); err != nil {
+		return fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	if err := nc.restoreNonces(nonces, block, complete); err != nil {
+		return fmt.Errorf("SQL store checkpoint at block %d: %w", block, err)
+	}
+	return nil
+}
+
+// writeBatch writes the pending events, the nonces of the tracked owners and the checkpoint, along with whether the
+// nonces are complete, in one transaction.
+func (s *SQLStore) writeBatch(ctx context.Context, nc *NonceCounter, batch Batch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, pending := range s.pending {
+		e, vLog := pending.event, pending.log
+		operatorIds, err := json.Marshal(e.OperatorIds)
+		if err != nil {
+			return err
+		}
+		// Rows of ranges whose transaction failed may have been written by an earlier attempt
+		if _, err := tx.ExecContext(ctx, `INSERT OR REPLACE INTO validator_added (block_number, log_index, block_hash,
+			tx_hash, owner, public_key, operator_ids, shares, cluster_validator_count, cluster_network_fee_index,
+			cluster_index, cluster_active, cluster_balance) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			vLog.BlockNumber, vLog.Index, vLog.BlockHash.Hex(), vLog.TxHash.Hex(), e.Owner.Hex(),
+			hexutil.Encode(e.PublicKey), string(operatorIds), hexutil.Encode(e.Shares), e.Cluster.ValidatorCount,
+			strconv.FormatUint(e.Cluster.NetworkFeeIndex, 10), strconv.FormatUint(e.Cluster.Index, 10), e.Cluster.Active,
+			e.Cluster.Balance.String()); err != nil {
+			return fmt.Errorf("failed to insert ValidatorAdded event: %w", err)
+		}
+	}
+
+	for owner, nonce := range nc.Nonces() {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO nonces (owner, next_nonce, block_number) VALUES (?, ?, ?)
+			ON CONFLICT (owner) DO UPDATE SET next_nonce = excluded.next_nonce, block_number = excluded.block_number`,
+			owner.Hex(), nonce, batch.ToBlock); err != nil {
+			return fmt.Errorf("failed to update nonce: %w", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `INSERT INTO checkpoint (id, block_number, complete, updated_at) VALUES (1, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET block_number = excluded.block_number, complete = excluded.complete,
+		updated_at = excluded.updated_at`,
+		batch.ToBlock, nc.Complete(), time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return fmt.Errorf("failed to update checkpoint: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.pending = nil
+	return nil
+}
+
+// migrate applies the migrations the database is missing, each in its own transaction.
+func (s *SQLStore) migrate(ctx context.Context) error {
+	if _, err := s.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		applied_at TEXT NOT NULL
+	)`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	version, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version > len(sqlMigrations) {
+		return fmt.Errorf("SQL store schema version %d is newer than the supported %d", version, len(sqlMigrations))
+	}
+
+	for i := version; i < len(sqlMigrations); i++ {
+		tx, err := s.db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, sqlMigrations[i]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to apply SQL store migration %d: %w", i+1, err)
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)",
+			i+1, time.Now().UTC().Format(time.RFC3339)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to record SQL store migration %d: %w", i+1, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to apply SQL store migration %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestSQLStore(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	untracked := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+	path := filepath.Join(t.TempDir(), "nonces.db")
+
+	store, err := OpenSQLStore(path)
+	if err != nil {
+		t.Fatalf("OpenSQLStore() error = %v", err)
+	}
+	if version, err := store.SchemaVersion(context.Background()); err != nil || version != len(sqlMigrations) {
+		t.Fatalf("SchemaVersion() = %d, %v, want %d", version, err, len(sqlMigrations))
+	}
+
+	nc := newReplayNonceCounter(t, &bytes.Buffer{}, owner)
+	if _, resumed, err := store.Attach(nc); err != nil || resumed {
+		t.Fatalf("Attach() to an empty store = %v, %v, want false, nil", resumed, err)
+	}
+
+	var logs []types.Log
+	for i, spec := range []struct {
+		owner common.Address
+		block uint64
+	}{{owner, 10}, {untracked, 10}, {owner, 12}} {
+		vLog := newValidatorAddedLog(t, contractAbi, spec.owner)
+		vLog.BlockNumber = spec.block
+		vLog.Index = uint(i)
+		logs = append(logs, vLog)
+	}
+	// uint64 indexes with the high bit set do not fit SQLite integers
+	logs[2].Data = newEventLog(t, contractAbi, "ValidatorAdded", []common.Hash{common.BytesToHash(owner.Bytes())},
+		[]uint64{1, 2, 3, 4}, []byte{0x01}, []byte{0x02}, ISSVNetworkCoreCluster{ValidatorCount: 1,
+			NetworkFeeIndex: math.MaxUint64, Index: 1 << 63, Balance: big.NewInt(1e18)}).Data
+	if err := nc.processBatch(context.Background(), Batch{FromBlock: 5, ToBlock: 20, Logs: len(logs)}, logs, nil); err != nil {
+		t.Fatalf("processBatch() error = %v", err)
+	}
+
+	db := store.DB()
+	var events, ownerEvents int
+	if err := db.QueryRow("SELECT COUNT(*), COUNT(CASE WHEN owner = ? THEN 1 END) FROM validator_added",
+		owner.Hex()).Scan(&events, &ownerEvents); err != nil || events != 3 || ownerEvents != 2 {
+		t.Errorf("validator_added rows = %d (%d of owner), %v, want 3 (2)", events, ownerEvents, err)
+	}
+	var operatorIds, networkFeeIndex, index, balance string
+	var validatorCount int
+	if err := db.QueryRow(`SELECT operator_ids, cluster_validator_count, cluster_network_fee_index, cluster_index,
+		cluster_balance FROM validator_added WHERE block_number = 12`).Scan(&operatorIds, &validatorCount,
+		&networkFeeIndex, &index, &balance); err != nil || operatorIds != "[1,2,3,4]" || validatorCount != 1 ||
+		networkFeeIndex != "18446744073709551615" || index != "9223372036854775808" || balance != "1000000000000000000" {
+		t.Errorf("validator_added row = %s, %d, %s, %s, %s, %v", operatorIds, validatorCount, networkFeeIndex, index,
+			balance, err)
+	}
+	var nonce, nonceBlock uint64
+	if err := db.QueryRow("SELECT next_nonce, block_number FROM nonces WHERE owner = ?", owner.Hex()).Scan(&nonce,
+		&nonceBlock); err != nil || nonce != 2 || nonceBlock != 20 {
+		t.Errorf("nonces row = %d, %d, %v, want 2, 20", nonce, nonceBlock, err)
+	}
+	if block, ok, err := store.Checkpoint(context.Background()); err != nil || !ok || block != 20 {
+		t.Errorf("Checkpoint() = %d, %v, %v, want 20, true", block, ok, err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// Reopening applies no migration and resumes after the checkpoint
+	store, err = OpenSQLStore(path)
+	if err != nil {
+		t.Fatalf("OpenSQLStore() of an existing store error = %v", err)
+	}
+	defer store.Close()
+	resumed := newReplayNonceCounter(t, &bytes.Buffer{}, owner)
+	if block, ok, err := store.Attach(resumed); err != nil || !ok || block != 21 {
+		t.Fatalf("Attach() = %d, %v, %v, want 21, true", block, ok, err)
+	}
+	if nonce, _ := resumed.NextNonce(owner); nonce != 2 {
+		t.Errorf("NextNonce() after Attach() = %d, want 2", nonce)
+	}
+
+	// A counter tracking an owner the store has no nonce for cannot resume from it
+	if _, _, err := store.Attach(newReplayNonceCounter(t, &bytes.Buffer{}, untracked)); err == nil {
+		t.Errorf("Attach() of a counter tracking an owner missing from the store succeeded")
+	}
+}
+
+func TestSQLStoreRestoresCompleteness(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	vLog := newValidatorAddedLog(t, contractAbi, owner)
+	vLog.BlockNumber = 10
+	rpcURL := newFakeRPC(t, &fakeEthService{head: 15, logs: []types.Log{vLog}})
+
+	tests := []struct {
+		name         string
+		startBlock   uint64
+		wantComplete bool
+	}{
+		{name: "filled from the deployment", startBlock: 5, wantComplete: true},
+		{name: "filled from after the deployment", startBlock: 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "nonces.db")
+			config := newTestConfig(&bytes.Buffer{}, owner)
+			config.DeploymentBlock = 5
+			// sync fills the store at path from startBlock, or from its checkpoint when it holds one
+			sync := func(startBlock uint64) *NonceCounter {
+				t.Helper()
+				store, err := OpenSQLStore(path)
+				if err != nil {
+					t.Fatalf("OpenSQLStore() error = %v", err)
+				}
+				defer store.Close()
+				nc := newTestNonceCounter(t, config)
+				if resume, resumed, err := store.Attach(nc); err != nil {
+					t.Fatalf("Attach() error = %v", err)
+				} else if resumed {
+					startBlock = resume
+				}
+				if _, err := nc.Sync(context.Background(), startBlock, rpcURL); err != nil {
+					t.Fatalf("Sync() error = %v", err)
+				}
+				return nc
+			}
+
+			sync(tt.startBlock)
+			restarted := sync(0)
+
+			if restarted.Complete() != tt.wantComplete {
+				t.Errorf("Complete() after restart = %v, want %v", restarted.Complete(), tt.wantComplete)
+			}
+			_, err := restarted.TakeSnapshot(context.Background(), rpcURL)
+			if tt.wantComplete && err != nil {
+				t.Errorf("TakeSnapshot() after restart error = %v", err)
+			}
+			if !tt.wantComplete && !errors.Is(err, ErrIncompleteNonces) {
+				t.Errorf("TakeSnapshot() after restart error = %v, want %v", err, ErrIncompleteNonces)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package noncecounter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultHeartbeatInterval is the interval between the heartbeats of an EventStream when none is configured.
+const DefaultHeartbeatInterval = 15 * time.Second
+
+// EventStream is an HTTP handler pushing the nonce increments of the tracked owners as Server-Sent Events.
+//
+// Every increment is sent as a "nonce" event whose ID is "<block>-<log index>", with an IncrementRecord carrying the
+// owner's next nonce as data. Streams start with a "nonces" event holding the current NonceRecords, unless they resume
+// from the Last-Event-ID header, the last_event_id parameter or the from_block parameter, in which case the increments
+// recorded after that point since the counter started are sent first. The owner parameter, repeated or comma separated,
+// restricts the stream to some owners. A "heartbeat" event with the scan progress is sent every heartbeat interval.
+type EventStream struct {
+	nc        *NonceCounter
+	heartbeat time.Duration
+}
+
+// nonceEvent is the data of a "nonce" event.
+type nonceEvent struct {
+	IncrementRecord
+	NextNonce uint64 `json:"nextNonce"`
+}
+
+// heartbeatEvent is the data of a "heartbeat" event.
+type heartbeatEvent struct {
+	LastBlock uint64 `json:"lastBlock"`
+	Processed bool   `json:"processed"`
+	Synced    bool   `json:"synced"`
+}
+
+// eventCursor is the position of a log in the chain, an event stream sends the increments after it.
+type eventCursor struct {
+	block uint64
+	// index is the log index, -1 for the position before the first log of block
+	index int64
+}
+
+// before reports whether the cursor is before the log of increment.
+func (c eventCursor) before(increment Increment) bool {
+	return increment.BlockNumber > c.block || increment.BlockNumber == c.block && int64(increment.LogIndex) > c.index
+}
+
+// NewEventStream returns an event stream of nc sending a heartbeat every heartbeat interval, DefaultHeartbeatInterval
+// when zero.
+func NewEventStream(nc *NonceCounter, heartbeat time.Duration) *EventStream {
+	if heartbeat <= 0 {
+		heartbeat = DefaultHeartbeatInterval
+	}
+	return &EventStream{nc: nc, heartbeat: heartbeat}
+}
+
+// ServeHTTP streams the events until the client disconnects or falls too far behind, it can then resume from the last
+// event it received.
+func (s *EventStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	filter, err := s.parseOwners(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	cursor, resume, err := parseEventCursor(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	watched := func(owner common.Address) bool {
+		return len(filter) == 0 || filter[owner]
+	}
+
+	nonces, increments, stop := s.nc.Watch(watchBuffer)
+	defer stop()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	// send writes the increment if it is after the cursor, so increments replayed from the history and received from
+	// the watcher are only sent once
+	send := func(increment Increment) error {
+		if !watched(increment.Owner) || !cursor.before(increment) {
+			return nil
+		}
+		cursor = eventCursor{block: increment.BlockNumber, index: int64(increment.LogIndex)}
+		id := fmt.Sprintf("%d-%d", increment.BlockNumber, increment.LogIndex)
+		return writeEvent(w, id, "nonce", nonceEvent{IncrementRecord: newIncrementRecord(increment), NextNonce: increment.Nonce + 1})
+	}
+
+	if resume {
+		for _, increment := range s.nc.History() {
+			if err := send(increment); err != nil {
+				return
+			}
+		}
+	} else {
+		block, _ := s.nc.LastBlock()
+		records := []NonceRecord{}
+		for _, owner := range sortedOwners(nonces) {
+			if watched(owner) {
+				records = append(records, NonceRecord{Owner: owner.Hex(), NextNonce: nonces[owner], Block: block})
+			}
+		}
+		if err := writeEvent(w, "", "nonces", records); err != nil {
+			return
+		}
+		// The watcher only receives the increments after the current nonces
+		cursor = eventCursor{index: -1}
+	}
+	flusher.Flush()
+
+	ticker := time.NewTicker(s.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			block, processed := s.nc.LastBlock()
+			if err := writeEvent(w, "", "heartbeat", heartbeatEvent{LastBlock: block, Processed: processed, Synced: s.nc.Synced()}); err != nil {
+				return
+			}
+		case increment, ok := <-increments:
+			if !ok {
+				// The client fell behind, it reconnects with the ID of the last event it received
+				return
+			}
+			if err := send(increment); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// parseOwners returns the owners the stream is restricted to, every tracked owner when empty.
+func (s *EventStream) parseOwners(r *http.Request) (map[common.Address]bool, error) {
+	filter := map[common.Address]bool{}
+	for _, param := range r.URL.Query()["owner"] {
+		for _, address := range strings.Split(param, ",") {
+			if !common.IsHexAddress(address) {
+				return nil, fmt.Errorf("invalid owner address %q", address)
+			}
+			owner := common.HexToAddress(address)
+			if !s.nc.isTracked(owner) {
+				return nil, fmt.Errorf("owner %s is not tracked", owner.Hex())
+			}
+			filter[owner] = true
+		}
+	}
+	return filter, nil
+}
+
+// parseEventCursor returns the position a stream resumes from, and false when it does not resume.
+func parseEventCursor(r *http.Request) (eventCursor, bool, error) {
+	id := r.Header.Get("Last-Event-ID")
+	if id == "" {
+		id = r.URL.Query().Get("last_event_id")
+	}
+	if id != "" {
+		block, index, ok := strings.Cut(id, "-")
+		number, err := strconv.ParseUint(block, 10, 64)
+		if !ok || err != nil {
+			return eventCursor{}, false, fmt.Errorf("invalid event ID %q", id)
+		}
+		logIndex, err := strconv.ParseUint(index, 10, 32)
+		if err != nil {
+			return eventCursor{}, false, fmt.Errorf("invalid event ID %q", id)
+		}
+		return eventCursor{block: number, index: int64(logIndex)}, true, nil
+	}
+
+	if fromBlock := r.URL.Query().Get("from_block"); fromBlock != "" {
+		number, err := strconv.ParseUint(fromBlock, 10, 64)
+		if err != nil {
+			return eventCursor{}, false, fmt.Errorf("invalid from_block %q", fromBlock)
+		}
+		return eventCursor{block: number, index: -1}, true, nil
+	}
+	return eventCursor{}, false, nil
+}
+
+// writeEvent writes a Server-Sent Event with data encoded as JSON, and an ID unless id is empty.
+func writeEvent(w http.ResponseWriter, id, event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
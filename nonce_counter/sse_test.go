@@ -0,0 +1,175 @@
+package noncecounter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// sseEvent is a Server-Sent Event read by readEvent.
+type sseEvent struct {
+	id, event, data string
+}
+
+// readEvent reads the next event of a stream.
+func readEvent(t *testing.T, reader *bufio.Reader) sseEvent {
+	t.Helper()
+
+	var event sseEvent
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("failed to read event: %v", err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return event
+		}
+		field, value, _ := strings.Cut(line, ": ")
+		switch field {
+		case "id":
+			event.id = value
+		case "event":
+			event.event = value
+		case "data":
+			event.data = value
+		}
+	}
+}
+
+func TestEventStream(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	otherOwner := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+	nc := newReplayNonceCounter(t, &bytes.Buffer{}, owner, otherOwner)
+	server := httptest.NewServer(NewEventStream(nc, time.Hour))
+	defer server.Close()
+
+	process := func(block uint64, owners ...common.Address) {
+		t.Helper()
+		var logs []types.Log
+		for i, owner := range owners {
+			vLog := newValidatorAddedLog(t, contractAbi, owner)
+			vLog.BlockNumber = block
+			vLog.Index = uint(i)
+			logs = append(logs, vLog)
+		}
+		if err := nc.processBatch(context.Background(), Batch{FromBlock: block, ToBlock: block, Logs: len(logs)}, logs, nil); err != nil {
+			t.Fatalf("processBatch() error = %v", err)
+		}
+	}
+	process(10, owner, otherOwner, owner)
+
+	open := func(query string, header http.Header) (*bufio.Reader, func()) {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, server.URL+query, nil)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to open stream: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+			t.Fatalf("stream status = %d, content type %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+		}
+		return bufio.NewReader(resp.Body), func() { resp.Body.Close() }
+	}
+
+	// A new stream starts with the current nonces of the requested owners
+	reader, closeStream := open("?owner="+owner.Hex(), nil)
+	defer closeStream()
+	event := readEvent(t, reader)
+	var records []NonceRecord
+	if err := json.Unmarshal([]byte(event.data), &records); err != nil || event.event != "nonces" ||
+		len(records) != 1 || records[0].Owner != owner.Hex() || records[0].NextNonce != 2 || records[0].Block != 10 {
+		t.Fatalf("first event = %+v, want the nonce of %s", event, owner.Hex())
+	}
+
+	process(11, otherOwner, owner)
+	event = readEvent(t, reader)
+	var update nonceEvent
+	if err := json.Unmarshal([]byte(event.data), &update); err != nil || event.event != "nonce" || event.id != "11-1" ||
+		update.Owner != owner.Hex() || update.Nonce != 2 || update.NextNonce != 3 || update.BlockNumber != 11 {
+		t.Errorf("nonce event = %+v, want the increment of %s at 11-1", event, owner.Hex())
+	}
+
+	// Resuming replays the increments after the last seen event
+	tests := []struct {
+		name    string
+		query   string
+		header  http.Header
+		wantIDs []string
+	}{
+		{
+			name:    "last event ID header",
+			header:  http.Header{"Last-Event-Id": {"10-1"}},
+			wantIDs: []string{"10-2", "11-0", "11-1"},
+		},
+		{
+			name:    "last event ID parameter with owner filter",
+			query:   "?last_event_id=10-0&owner=" + otherOwner.Hex(),
+			wantIDs: []string{"10-1", "11-0"},
+		},
+		{
+			name:    "from block",
+			query:   "?from_block=11",
+			wantIDs: []string{"11-0", "11-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, closeStream := open(tt.query, tt.header)
+			defer closeStream()
+			for _, want := range tt.wantIDs {
+				if event := readEvent(t, reader); event.event != "nonce" || event.id != want {
+					t.Errorf("event = %+v, want nonce event %s", event, want)
+				}
+			}
+		})
+	}
+
+	for _, query := range []string{"?owner=0x01", "?owner=" + common.HexToAddress("0x02").Hex(), "?last_event_id=10", "?from_block=x"} {
+		resp, err := http.Get(server.URL + query)
+		if err != nil {
+			t.Fatalf("failed to open stream: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("stream %s status = %d, want %d", query, resp.StatusCode, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestEventStreamHeartbeat(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	nc := newReplayNonceCounter(t, &bytes.Buffer{}, owner)
+	nc.lastBlock.Store(42)
+	nc.processed.Store(true)
+	server := httptest.NewServer(NewEventStream(nc, 10*time.Millisecond))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("failed to open stream: %v", err)
+	}
+	defer resp.Body.Close()
+	reader := bufio.NewReader(resp.Body)
+	readEvent(t, reader)
+
+	event := readEvent(t, reader)
+	var heartbeat heartbeatEvent
+	if err := json.Unmarshal([]byte(event.data), &heartbeat); err != nil || event.event != "heartbeat" ||
+		heartbeat.LastBlock != 42 || !heartbeat.Processed || heartbeat.Synced {
+		t.Errorf("heartbeat = %+v, want block 42 processed", event)
+	}
+}
@@ -0,0 +1,61 @@
+package noncecounter
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// abiSignatures lists every event, method and error of contractAbi together with the argument details the
+// generated bindings depend on.
+func abiSignatures(contractAbi abi.ABI) []string {
+	arguments := func(args abi.Arguments) string {
+		var s string
+		for _, arg := range args {
+			s += fmt.Sprintf("%s %s indexed=%t,", arg.Type, arg.Name, arg.Indexed)
+		}
+		return s
+	}
+
+	var signatures []string
+	for _, event := range contractAbi.Events {
+		signatures = append(signatures, fmt.Sprintf("event %s(%s) anonymous=%t", event.Name, arguments(event.Inputs), event.Anonymous))
+	}
+	for _, method := range contractAbi.Methods {
+		signatures = append(signatures, fmt.Sprintf("function %s(%s) (%s) %s", method.Name, arguments(method.Inputs), arguments(method.Outputs), method.StateMutability))
+	}
+	for _, abiError := range contractAbi.Errors {
+		signatures = append(signatures, fmt.Sprintf("error %s(%s)", abiError.Name, arguments(abiError.Inputs)))
+	}
+	slices.Sort(signatures)
+	return signatures
+}
+
+// TestBindingsMatchABI fails when ssv_network_bindings.go was generated from a different ABI than the one the CLI
+// ships, in which case the bindings must be regenerated with go generate.
+func TestBindingsMatchABI(t *testing.T) {
+	abiJSON, err := os.ReadFile("../cmd/ssv_network.abi.json")
+	if err != nil {
+		t.Fatalf("failed to read ABI: %v", err)
+	}
+
+	want := abiSignatures(mustParseABI(t, string(abiJSON)))
+	got := abiSignatures(mustParseABI(t, SSVNetworkMetaData.ABI))
+
+	for _, signature := range want {
+		if !slices.Contains(got, signature) {
+			t.Errorf("bindings are missing %s", signature)
+		}
+	}
+	for _, signature := range got {
+		if !slices.Contains(want, signature) {
+			t.Errorf("bindings have stale %s", signature)
+		}
+	}
+	if t.Failed() {
+		t.Log("bindings are out of date with ../cmd/ssv_network.abi.json, run go generate ./...")
+	}
+}
@@ -0,0 +1,167 @@
+package noncecounter
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"golang.org/x/sync/semaphore"
+)
+
+// transactionReader is the part of the Ethereum client needed to attribute registrations to their transactions.
+type transactionReader interface {
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionSender(ctx context.Context, tx *types.Transaction, block common.Hash, index uint) (common.Address, error)
+}
+
+// Submission is a transaction that registered validators of a tracked owner, grouping the nonce increments it caused.
+type Submission struct {
+	TxHash      common.Hash
+	BlockNumber uint64
+	BlockHash   common.Hash
+	TxIndex     uint
+	Owner       common.Address
+	// Nonces are the owner nonces consumed by the ValidatorAdded events of the transaction, in log order.
+	Nonces []uint64
+	// Attributed is set once the transaction was fetched, Method, Sender and Validators are only known from then on.
+	Attributed bool
+	// Method is the called contract function, empty when the calldata is not a registration, like for transactions
+	// relayed through another contract.
+	Method string
+	Sender common.Address
+	// Validators is the number of validators registered by the calldata, 0 when it was not decoded.
+	Validators int
+}
+
+// Submissions returns the transactions that registered validators of owner, in chain order. They are only recorded
+// when AttributeSubmissions is enabled.
+func (nc *NonceCounter) Submissions(owner common.Address) []Submission {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	var submissions []Submission
+	for _, submission := range nc.submissions {
+		if submission.Owner == owner {
+			submissions = append(submissions, submission.clone())
+		}
+	}
+	slices.SortFunc(submissions, func(a, b Submission) int {
+		return cmp.Or(cmp.Compare(a.BlockNumber, b.BlockNumber), cmp.Compare(a.TxIndex, b.TxIndex))
+	})
+	return submissions
+}
+
+// recordSubmission adds the nonce consumed by a ValidatorAdded log of a tracked owner to the submission of its
+// transaction, queueing the submission for attribution when it is new.
+func (nc *NonceCounter) recordSubmission(owner common.Address, nonce uint64, vLog types.Log) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	submission, ok := nc.submissions[vLog.TxHash]
+	if !ok {
+		submission = &Submission{
+			TxHash:      vLog.TxHash,
+			BlockNumber: vLog.BlockNumber,
+			BlockHash:   vLog.BlockHash,
+			TxIndex:     vLog.TxIndex,
+			Owner:       owner,
+		}
+		nc.submissions[vLog.TxHash] = submission
+		nc.unattributed = append(nc.unattributed, vLog.TxHash)
+	}
+	submission.Nonces = append(submission.Nonces, nonce)
+}
+
+// fetchSubmissions fetches the transactions of the submissions recorded since the last call and decodes their
+// calldata. Submissions whose transaction can't be fetched stay queued and are retried on the next call.
+func (nc *NonceCounter) fetchSubmissions(ctx context.Context, client transactionReader) {
+	nc.mu.Lock()
+	queued := make([]Submission, 0, len(nc.unattributed))
+	for _, hash := range nc.unattributed {
+		queued = append(queued, nc.submissions[hash].clone())
+	}
+	nc.unattributed = nil
+	nc.mu.Unlock()
+
+	attributed := make([]bool, len(queued))
+	sem := semaphore.NewWeighted(nc.concurrency)
+	var wg sync.WaitGroup
+	for i := range queued {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			break
+		}
+		wg.Add(1)
+		go func(submission *Submission) {
+			defer sem.Release(1)
+			defer wg.Done()
+
+			if err := nc.attributeSubmission(ctx, client, submission); err != nil {
+				nc.Logger().Warn("failed to attribute registrations, retrying with the next block range",
+					"tx_hash", submission.TxHash.Hex(), "error", err)
+				return
+			}
+			attributed[i] = true
+		}(&queued[i])
+	}
+	wg.Wait()
+
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+	for i, submission := range queued {
+		if !attributed[i] {
+			nc.unattributed = append(nc.unattributed, submission.TxHash)
+			continue
+		}
+		stored := nc.submissions[submission.TxHash]
+		stored.Attributed = true
+		stored.Method = submission.Method
+		stored.Sender = submission.Sender
+		stored.Validators = submission.Validators
+		fmt.Fprintln(nc.out(), stored)
+	}
+}
+
+// attributeSubmission fills the calldata details of submission from its transaction.
+func (nc *NonceCounter) attributeSubmission(ctx context.Context, client transactionReader, submission *Submission) error {
+	tx, _, err := client.TransactionByHash(ctx, submission.TxHash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch transaction: %w", err)
+	}
+	submission.Sender, err = client.TransactionSender(ctx, tx, submission.BlockHash, submission.TxIndex)
+	if err != nil {
+		return fmt.Errorf("failed to recover transaction sender: %w", err)
+	}
+
+	if tx.To() == nil || *tx.To() != common.HexToAddress(nc.contractAddress) {
+		return nil
+	}
+	call, err := DecodeRegistrationCall(nc.contractAbi, tx.Data())
+	if err != nil {
+		nc.Logger().Warn("failed to decode calldata", "tx_hash", submission.TxHash.Hex(), "error", err)
+		return nil
+	}
+	submission.Method = call.Method
+	submission.Validators = len(call.PublicKeys)
+	return nil
+}
+
+// String returns a one line description of the submission.
+func (s Submission) String() string {
+	if s.Method == "" {
+		return fmt.Sprintf("Tx %s (block %d): undecoded call by %s, owner %s nonces %v",
+			s.TxHash.Hex(), s.BlockNumber, s.Sender.Hex(), s.Owner.Hex(), s.Nonces)
+	}
+	return fmt.Sprintf("Tx %s (block %d): %s by %s registered %d validators, owner %s nonces %v",
+		s.TxHash.Hex(), s.BlockNumber, s.Method, s.Sender.Hex(), s.Validators, s.Owner.Hex(), s.Nonces)
+}
+
+// clone returns a deep copy of s so the state handed out is not affected by later events.
+func (s *Submission) clone() Submission {
+	c := *s
+	c.Nonces = slices.Clone(s.Nonces)
+	return c
+}
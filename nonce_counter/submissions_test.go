@@ -0,0 +1,125 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// fakeTransactionReader serves transactions by hash, failing for the ones in unavailable.
+type fakeTransactionReader struct {
+	txs         map[common.Hash]*types.Transaction
+	unavailable map[common.Hash]bool
+}
+
+func (f fakeTransactionReader) TransactionByHash(_ context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	if f.unavailable[hash] {
+		return nil, false, errors.New("transaction not available")
+	}
+	tx, ok := f.txs[hash]
+	if !ok {
+		return nil, false, errors.New("not found")
+	}
+	return tx, false, nil
+}
+
+func (f fakeTransactionReader) TransactionSender(_ context.Context, tx *types.Transaction, _ common.Hash, _ uint) (common.Address, error) {
+	return types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+}
+
+func TestFetchSubmissions(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	contract := common.HexToAddress("0x38A4794cCEd47d3baf7370CcC43B560D3a1beEFA")
+	ownerKey, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	owner := crypto.PubkeyToAddress(ownerKey.PublicKey)
+	relayer := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+
+	keys := [][]byte{bytes.Repeat([]byte{0x01}, 48), bytes.Repeat([]byte{0x02}, 48), bytes.Repeat([]byte{0x03}, 48)}
+	bulk := newSignedTx(t, ownerKey, 0, contract, packRegistration(t, contractAbi, true, keys...))
+	single := newSignedTx(t, ownerKey, 1, contract, packRegistration(t, contractAbi, false, keys[0]))
+	relayed := newSignedTx(t, ownerKey, 2, relayer, []byte{0xca, 0xfe})
+	late := newSignedTx(t, ownerKey, 3, contract, packRegistration(t, contractAbi, false, keys[1]))
+
+	logsOf := func(tx *types.Transaction, block uint64, count int) []types.Log {
+		logs := make([]types.Log, count)
+		for i := range logs {
+			logs[i] = newValidatorAddedLog(t, contractAbi, owner)
+			logs[i].TxHash = tx.Hash()
+			logs[i].BlockNumber = block
+		}
+		return logs
+	}
+	var logs []types.Log
+	logs = append(logs, logsOf(bulk, 10, 3)...)
+	logs = append(logs, logsOf(single, 11, 1)...)
+	logs = append(logs, logsOf(relayed, 12, 1)...)
+	logs = append(logs, logsOf(late, 13, 1)...)
+
+	nc := &NonceCounter{
+		contractAddress:      contract.Hex(),
+		eventName:            "ValidatorAdded",
+		contractAbi:          contractAbi,
+		addresses:            []string{owner.Hex()},
+		addressToNonce:       map[string]uint64{owner.Hex(): 5},
+		concurrency:          2,
+		registry:             NewRegistry(contractAbi),
+		attributeSubmissions: true,
+		submissions:          map[common.Hash]*Submission{},
+	}
+	if err := Handle(nc.registry, nc.eventName, nc.handleValidatorAdded); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if _, err := nc.FindNonces(context.Background(), logs); err != nil {
+		t.Fatalf("FindNonces() error = %v", err)
+	}
+
+	client := fakeTransactionReader{
+		txs:         map[common.Hash]*types.Transaction{},
+		unavailable: map[common.Hash]bool{late.Hash(): true},
+	}
+	for _, tx := range []*types.Transaction{bulk, single, relayed, late} {
+		client.txs[tx.Hash()] = tx
+	}
+	nc.fetchSubmissions(context.Background(), client)
+
+	want := []Submission{
+		{TxHash: bulk.Hash(), BlockNumber: 10, Owner: owner, Nonces: []uint64{5, 6, 7}, Attributed: true,
+			Method: "bulkRegisterValidator", Sender: owner, Validators: 3},
+		{TxHash: single.Hash(), BlockNumber: 11, Owner: owner, Nonces: []uint64{8}, Attributed: true,
+			Method: "registerValidator", Sender: owner, Validators: 1},
+		{TxHash: relayed.Hash(), BlockNumber: 12, Owner: owner, Nonces: []uint64{9}, Attributed: true,
+			Sender: owner},
+		{TxHash: late.Hash(), BlockNumber: 13, Owner: owner, Nonces: []uint64{10}},
+	}
+	assertSubmissions := func(t *testing.T, got, want []Submission) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Fatalf("Submissions() = %d submissions, want %d", len(got), len(want))
+		}
+		for i := range want {
+			if got[i].String() != want[i].String() || got[i].Attributed != want[i].Attributed {
+				t.Errorf("Submissions()[%d] = %v (attributed %v), want %v (attributed %v)", i, got[i],
+					got[i].Attributed, want[i], want[i].Attributed)
+			}
+		}
+	}
+	assertSubmissions(t, nc.Submissions(owner), want)
+
+	// Transactions that could not be fetched are retried
+	delete(client.unavailable, late.Hash())
+	nc.fetchSubmissions(context.Background(), client)
+	want[3].Attributed = true
+	want[3].Method = "registerValidator"
+	want[3].Sender = owner
+	want[3].Validators = 1
+	assertSubmissions(t, nc.Submissions(owner), want)
+
+	if got := nc.Submissions(relayer); len(got) != 0 {
+		t.Errorf("Submissions() of an untracked owner = %v, want none", got)
+	}
+}
@@ -0,0 +1,199 @@
+package noncecounter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// blockTimeCacheSize is the number of block timestamps a BlockClock keeps.
+	blockTimeCacheSize = 8192
+	// headerBatchSize is the number of headers requested in a single RPC batch.
+	headerBatchSize = 100
+)
+
+// batchCaller is the part of the RPC client needed to fetch headers in batches.
+type batchCaller interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// timeSource resolves block numbers to their timestamps, a BlockClock or the timestamps of a recording.
+type timeSource interface {
+	Times(ctx context.Context, blocks []uint64) (map[uint64]time.Time, error)
+}
+
+// blockTime is the part of a block header a BlockClock needs.
+type blockTime struct {
+	Number    hexutil.Uint64 `json:"number"`
+	Timestamp hexutil.Uint64 `json:"timestamp"`
+}
+
+// BlockClock maps block numbers to block timestamps and back, fetching headers in batches and caching their times.
+type BlockClock struct {
+	client batchCaller
+	times  *lru.Cache[uint64, uint64]
+}
+
+// NewBlockClock creates a BlockClock reading headers through client, an ethclient.Client's Client() for example.
+func NewBlockClock(client *rpc.Client) *BlockClock {
+	return newBlockClock(client)
+}
+
+// newBlockClock creates a BlockClock over any batchCaller.
+func newBlockClock(client batchCaller) *BlockClock {
+	return &BlockClock{
+		client: client,
+		times:  lru.NewCache[uint64, uint64](blockTimeCacheSize),
+	}
+}
+
+// Times returns the timestamps of the given blocks.
+func (bc *BlockClock) Times(ctx context.Context, blocks []uint64) (map[uint64]time.Time, error) {
+	times := make(map[uint64]time.Time, len(blocks))
+	var missing []uint64
+	for _, block := range blocks {
+		if timestamp, ok := bc.times.Get(block); ok {
+			times[block] = time.Unix(int64(timestamp), 0)
+		} else if !slices.Contains(missing, block) {
+			missing = append(missing, block)
+		}
+	}
+
+	for chunk := range slices.Chunk(missing, headerBatchSize) {
+		headers := make([]*blockTime, len(chunk))
+		batch := make([]rpc.BatchElem, len(chunk))
+		for i, block := range chunk {
+			batch[i] = rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args:   []any{hexutil.EncodeUint64(block), false},
+				Result: &headers[i],
+			}
+		}
+		if err := bc.client.BatchCallContext(ctx, batch); err != nil {
+			return nil, fmt.Errorf("failed to fetch block headers: %w", err)
+		}
+
+		for i, block := range chunk {
+			if batch[i].Error != nil {
+				return nil, fmt.Errorf("failed to fetch header of block %d: %w", block, batch[i].Error)
+			}
+			if headers[i] == nil {
+				return nil, fmt.Errorf("block %d not found", block)
+			}
+			bc.times.Add(block, uint64(headers[i].Timestamp))
+			times[block] = time.Unix(int64(headers[i].Timestamp), 0)
+		}
+	}
+	return times, nil
+}
+
+// BlockAt returns the first block with a timestamp at or after t, found by binary search over the headers up to the
+// chain head. It fails when every block is older than t.
+func (bc *BlockClock) BlockAt(ctx context.Context, t time.Time) (uint64, error) {
+	head, headTime, err := bc.head(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if headTime.Before(t) {
+		return 0, fmt.Errorf("chain head %d at %s is older than %s", head, headTime.UTC().Format(time.RFC3339),
+			t.UTC().Format(time.RFC3339))
+	}
+
+	low, high := uint64(0), head
+	for low < high {
+		mid := low + (high-low)/2
+		midTime, err := bc.blockTime(ctx, mid)
+		if err != nil {
+			return 0, err
+		}
+		if midTime.Before(t) {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low, nil
+}
+
+// BlockUntil returns the last block with a timestamp at or before t, the chain head when t is at or after its time. It
+// fails when every block is newer than t.
+func (bc *BlockClock) BlockUntil(ctx context.Context, t time.Time) (uint64, error) {
+	head, headTime, err := bc.head(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !headTime.After(t) {
+		return head, nil
+	}
+
+	// Block times are whole seconds, the last block up to t is the one before the first block after it
+	block, err := bc.BlockAt(ctx, t.Truncate(time.Second).Add(time.Second))
+	if err != nil {
+		return 0, err
+	}
+	if block == 0 {
+		return 0, fmt.Errorf("no block was produced up to %s", t.UTC().Format(time.RFC3339))
+	}
+	return block - 1, nil
+}
+
+// head returns the number and the timestamp of the chain head.
+func (bc *BlockClock) head(ctx context.Context) (uint64, time.Time, error) {
+	var head hexutil.Uint64
+	batch := []rpc.BatchElem{{Method: "eth_blockNumber", Result: &head}}
+	if err := bc.client.BatchCallContext(ctx, batch); err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to fetch chain head: %w", err)
+	}
+	if batch[0].Error != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to fetch chain head: %w", batch[0].Error)
+	}
+
+	headTime, err := bc.blockTime(ctx, uint64(head))
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return uint64(head), headTime, nil
+}
+
+// blockTime returns the timestamp of block.
+func (bc *BlockClock) blockTime(ctx context.Context, block uint64) (time.Time, error) {
+	times, err := bc.Times(ctx, []uint64{block})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return times[block], nil
+}
+
+// stampIncrements sets the block timestamp of the increments recorded since the last successful call. On failure
+// they are left for the next call.
+func (nc *NonceCounter) stampIncrements(ctx context.Context, clock timeSource) {
+	nc.mu.Lock()
+	pending := slices.Clone(nc.history[nc.stamped:])
+	nc.mu.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+
+	blocks := make([]uint64, len(pending))
+	for i, increment := range pending {
+		blocks[i] = increment.BlockNumber
+	}
+	times, err := clock.Times(ctx, blocks)
+	if err != nil {
+		nc.Logger().Warn("failed to fetch block timestamps", "error", err)
+		return
+	}
+
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+	for i := nc.stamped; i < nc.stamped+len(pending); i++ {
+		nc.history[i].Timestamp = times[nc.history[i].BlockNumber]
+	}
+	nc.stamped += len(pending)
+}
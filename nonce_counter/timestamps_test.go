@@ -0,0 +1,223 @@
+package noncecounter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// fakeChain serves the headers of a chain with a block every 12 seconds from genesisTime, up to head.
+type fakeChain struct {
+	genesisTime uint64
+	head        uint64
+	// requested counts the headers fetched.
+	requested int
+}
+
+func (fc *fakeChain) blockTime(block uint64) uint64 {
+	return fc.genesisTime + 12*block
+}
+
+func (fc *fakeChain) BatchCallContext(_ context.Context, b []rpc.BatchElem) error {
+	for i := range b {
+		var result any
+		switch b[i].Method {
+		case "eth_blockNumber":
+			result = hexutil.Uint64(fc.head)
+		case "eth_getBlockByNumber":
+			fc.requested++
+			block, err := hexutil.DecodeUint64(b[i].Args[0].(string))
+			if err != nil {
+				return err
+			}
+			if block <= fc.head {
+				result = map[string]any{"number": hexutil.Uint64(block), "timestamp": hexutil.Uint64(fc.blockTime(block))}
+			}
+		default:
+			b[i].Error = fmt.Errorf("method %s not supported", b[i].Method)
+			continue
+		}
+
+		data, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, b[i].Result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestBlockClockTimes(t *testing.T) {
+	chain := &fakeChain{genesisTime: 1700000000, head: 1000}
+	clock := newBlockClock(chain)
+
+	blocks := make([]uint64, 0, 250)
+	for block := uint64(0); block < 250; block++ {
+		blocks = append(blocks, block)
+	}
+	blocks = append(blocks, 3, 3)
+
+	times, err := clock.Times(context.Background(), blocks)
+	if err != nil {
+		t.Fatalf("Times() error = %v", err)
+	}
+	for _, block := range blocks {
+		if want := time.Unix(int64(chain.blockTime(block)), 0); !times[block].Equal(want) {
+			t.Errorf("Times()[%d] = %v, want %v", block, times[block], want)
+		}
+	}
+	if chain.requested != 250 {
+		t.Errorf("fetched %d headers, want 250", chain.requested)
+	}
+
+	// Cached times are not fetched again
+	if _, err := clock.Times(context.Background(), []uint64{1, 2, 250}); err != nil {
+		t.Fatalf("Times() error = %v", err)
+	}
+	if chain.requested != 251 {
+		t.Errorf("fetched %d headers, want 251", chain.requested)
+	}
+
+	if _, err := clock.Times(context.Background(), []uint64{2000}); err == nil {
+		t.Errorf("Times() of a block past the head succeeded")
+	}
+}
+
+func TestBlockClockBlockAt(t *testing.T) {
+	chain := &fakeChain{genesisTime: 1700000000, head: 1000}
+	genesis := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name    string
+		time    time.Time
+		want    uint64
+		wantErr bool
+	}{
+		{
+			name: "before genesis",
+			time: genesis.Add(-time.Hour),
+			want: 0,
+		},
+		{
+			name: "exact block time",
+			time: genesis.Add(120 * time.Second),
+			want: 10,
+		},
+		{
+			name: "between blocks",
+			time: genesis.Add(121 * time.Second),
+			want: 11,
+		},
+		{
+			name: "head",
+			time: genesis.Add(12000 * time.Second),
+			want: 1000,
+		},
+		{
+			name:    "after head",
+			time:    genesis.Add(12001 * time.Second),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newBlockClock(chain).BlockAt(context.Background(), tt.time)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BlockAt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("BlockAt() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStampIncrements(t *testing.T) {
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	chain := &fakeChain{genesisTime: 1700000000, head: 100}
+	clock := newBlockClock(chain)
+
+	nc := &NonceCounter{
+		history: []Increment{
+			{Owner: owner, Nonce: 0, BlockNumber: 10},
+			{Owner: owner, Nonce: 1, BlockNumber: 10},
+			{Owner: owner, Nonce: 2, BlockNumber: 50},
+		},
+	}
+	nc.stampIncrements(context.Background(), clock)
+
+	// Increments of blocks that can't be fetched yet are retried on the next call
+	nc.history = append(nc.history, Increment{Owner: owner, Nonce: 3, BlockNumber: 150})
+	nc.stampIncrements(context.Background(), clock)
+	chain.head = 200
+	nc.stampIncrements(context.Background(), clock)
+
+	for _, increment := range nc.Increments(owner) {
+		if want := time.Unix(int64(chain.blockTime(increment.BlockNumber)), 0); !increment.Timestamp.Equal(want) {
+			t.Errorf("increment of nonce %d at block %d has timestamp %v, want %v", increment.Nonce,
+				increment.BlockNumber, increment.Timestamp, want)
+		}
+	}
+	if chain.requested != 4 {
+		t.Errorf("fetched %d headers, want 4", chain.requested)
+	}
+}
+
+func TestBlockClockBlockUntil(t *testing.T) {
+	chain := &fakeChain{genesisTime: 1700000000, head: 1000}
+	genesis := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name    string
+		time    time.Time
+		want    uint64
+		wantErr bool
+	}{
+		{
+			name:    "before genesis",
+			time:    genesis.Add(-time.Second),
+			wantErr: true,
+		},
+		{
+			name: "exact block time",
+			time: genesis.Add(120 * time.Second),
+			want: 10,
+		},
+		{
+			name: "between blocks",
+			time: genesis.Add(131*time.Second + 500*time.Millisecond),
+			want: 10,
+		},
+		{
+			name: "head",
+			time: genesis.Add(12000 * time.Second),
+			want: 1000,
+		},
+		{
+			name: "after head",
+			time: genesis.Add(24 * time.Hour),
+			want: 1000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newBlockClock(chain).BlockUntil(context.Background(), tt.time)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BlockUntil() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("BlockUntil() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
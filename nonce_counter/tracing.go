@@ -0,0 +1,110 @@
+package noncecounter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the spans of the counter.
+const tracerName = "github.com/rem1niscence/ssv-nounce-counter/nonce_counter"
+
+// TracingServiceName is the service.name resource attribute of the spans exported by the tracer providers built here.
+const TracingServiceName = "ssv-nonce-counter"
+
+// Span attributes, named like the fields of the structured logs.
+const (
+	fromBlockKey    = attribute.Key("from_block")
+	toBlockKey      = attribute.Key("to_block")
+	headBlockKey    = attribute.Key("head_block")
+	logCountKey     = attribute.Key("log_count")
+	decodeErrorsKey = attribute.Key("decode_errors")
+	nonceChangedKey = attribute.Key("nonce_changed")
+)
+
+// OTLPConfig configures the OTLP trace exporter of NewOTLPTracerProvider.
+type OTLPConfig struct {
+	// Endpoint is the host:port of the OTLP gRPC collector, the OTEL_EXPORTER_OTLP_ENDPOINT environment variable or
+	// localhost:4317 when empty.
+	Endpoint string
+	// Insecure disables TLS towards the collector.
+	Insecure bool
+}
+
+// NewOTLPTracerProvider returns a tracer provider exporting spans in batches to an OTLP gRPC collector. It must be
+// shut down to flush the spans still buffered.
+func NewOTLPTracerProvider(ctx context.Context, config OTLPConfig) (*sdktrace.TracerProvider, error) {
+	var options []otlptracegrpc.Option
+	if config.Endpoint != "" {
+		options = append(options, otlptracegrpc.WithEndpoint(config.Endpoint))
+	}
+	if config.Insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	return newTracerProvider(sdktrace.WithBatcher(exporter))
+}
+
+// NewStdoutTracerProvider returns a tracer provider writing every span to w as JSON once it ends, for tests and local
+// debugging.
+func NewStdoutTracerProvider(w io.Writer) (*sdktrace.TracerProvider, error) {
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(w))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stdout trace exporter: %w", err)
+	}
+	return newTracerProvider(sdktrace.WithSyncer(exporter))
+}
+
+// newTracerProvider returns a tracer provider exporting through exporter, with the service resource attributes.
+func newTracerProvider(exporter sdktrace.TracerProviderOption) (*sdktrace.TracerProvider, error) {
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(TracingServiceName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build trace resource: %w", err)
+	}
+	return sdktrace.NewTracerProvider(exporter, sdktrace.WithResource(res)), nil
+}
+
+// newTracer returns the tracer of the counter from provider, the global tracer provider when nil.
+func newTracer(provider trace.TracerProvider) trace.Tracer {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return provider.Tracer(tracerName)
+}
+
+// startSpan starts a span of the counter, through the global tracer provider for counters not built by
+// NewNonceCounter.
+func (nc *NonceCounter) startSpan(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if nc.tracer == nil {
+		return otel.Tracer(tracerName).Start(ctx, name, options...)
+	}
+	return nc.tracer.Start(ctx, name, options...)
+}
+
+// blockRangeAttributes returns the span attributes of the block range of query bounds.
+func blockRangeAttributes(from, to uint64) []attribute.KeyValue {
+	return []attribute.KeyValue{fromBlockKey.Int64(int64(from)), toBlockKey.Int64(int64(to))}
+}
+
+// endSpan records err on span, unless nil, and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,100 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// exportedSpan holds the fields of the spans written by the stdout exporter the tests check.
+type exportedSpan struct {
+	Name        string
+	SpanContext struct{ TraceID, SpanID string }
+	Parent      struct{ SpanID string }
+	Attributes  []struct {
+		Key   string
+		Value struct{ Value any }
+	}
+}
+
+func (s exportedSpan) attribute(key string) any {
+	for _, attribute := range s.Attributes {
+		if attribute.Key == key {
+			return attribute.Value.Value
+		}
+	}
+	return nil
+}
+
+func TestSyncTracing(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	vLog := newValidatorAddedLog(t, contractAbi, owner)
+	vLog.BlockNumber = 10
+
+	rpcURL := newFakeRPC(t, &fakeEthService{head: 12, logs: []types.Log{vLog}})
+
+	var spans bytes.Buffer
+	provider, err := NewStdoutTracerProvider(&spans)
+	if err != nil {
+		t.Fatalf("NewStdoutTracerProvider() error = %v", err)
+	}
+	defer provider.Shutdown(context.Background())
+
+	config := newTestConfig(&bytes.Buffer{}, owner)
+	config.TracerProvider = provider
+	nc := newTestNonceCounter(t, config)
+	if _, err := nc.Sync(context.Background(), 5, rpcURL); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	byName := map[string]exportedSpan{}
+	decoder := json.NewDecoder(&spans)
+	for decoder.More() {
+		var span exportedSpan
+		if err := decoder.Decode(&span); err != nil {
+			t.Fatalf("invalid span: %v", err)
+		}
+		if _, ok := byName[span.Name]; ok {
+			t.Fatalf("span %s exported twice", span.Name)
+		}
+		byName[span.Name] = span
+	}
+
+	root, ok := byName["NonceCounter.Sync"]
+	if !ok {
+		t.Fatalf("no NonceCounter.Sync span in %v", byName)
+	}
+	tests := []struct {
+		name       string
+		attributes map[string]any
+	}{
+		{name: "NonceCounter.Sync", attributes: map[string]any{"from_block": 5.0, "to_block": 12.0, "head_block": 12.0, "log_count": 1.0}},
+		{name: "NonceCounter.prepareQuery", attributes: map[string]any{"from_block": 5.0, "to_block": 12.0, "head_block": 12.0}},
+		{name: "FilterLogs", attributes: map[string]any{"from_block": 5.0, "to_block": 12.0, "log_count": 1.0}},
+		{name: "NonceCounter.FindNonces", attributes: map[string]any{"log_count": 1.0, "decode_errors": 0.0, "nonce_changed": true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			span, ok := byName[tt.name]
+			if !ok {
+				t.Fatalf("no %s span", tt.name)
+			}
+			if span.SpanContext.TraceID != root.SpanContext.TraceID {
+				t.Errorf("trace ID = %s, want %s", span.SpanContext.TraceID, root.SpanContext.TraceID)
+			}
+			if span.Name != root.Name && span.Parent.SpanID != root.SpanContext.SpanID {
+				t.Errorf("parent span ID = %s, want %s", span.Parent.SpanID, root.SpanContext.SpanID)
+			}
+			for key, want := range tt.attributes {
+				if got := span.attribute(key); got != want {
+					t.Errorf("attribute %s = %v, want %v", key, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package noncecounter
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrValidatorRegistered is returned by CheckRegistration when the validator is already registered by the owner.
+var ErrValidatorRegistered = errors.New("validator already registered")
+
+// ValidatorStatus is the lifecycle state of a validator on the network.
+type ValidatorStatus int
+
+// Validator statuses, in lifecycle order.
+const (
+	ValidatorActive ValidatorStatus = iota
+	ValidatorExited
+	ValidatorRemoved
+)
+
+// String returns the lowercase name of the status.
+func (vs ValidatorStatus) String() string {
+	switch vs {
+	case ValidatorActive:
+		return "active"
+	case ValidatorExited:
+		return "exited"
+	case ValidatorRemoved:
+		return "removed"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(vs))
+	}
+}
+
+// Validator is the locally indexed state of a validator, from its latest registration.
+type Validator struct {
+	PublicKey   []byte
+	Owner       common.Address
+	OperatorIds []uint64
+	AddedBlock  uint64
+	AddedTx     common.Hash
+	// Tracked is set when the owner is tracked by the NonceCounter the registry is attached to, Nonce is only known
+	// then.
+	Tracked bool
+	// Nonce is the owner nonce the registration consumed, as counted by the NonceCounter.
+	Nonce  uint64
+	Status ValidatorStatus
+	// StatusBlock is the block of the event that set the current status.
+	StatusBlock uint64
+}
+
+// ValidatorRegistry indexes validators by BLS public key and owner from the ValidatorAdded, ValidatorExited and
+// ValidatorRemoved events. The nonces of the registrations are the ones counted by the NonceCounter it is attached to.
+type ValidatorRegistry struct {
+	mu sync.RWMutex
+	// validators are keyed by validatorID, the contract tells apart the same public key registered by two owners
+	validators map[common.Hash]*Validator
+}
+
+// validatorID returns the key the contract stores a validator under, keccak256 of the public key and the owner.
+func validatorID(publicKey []byte, owner common.Address) common.Hash {
+	return crypto.Keccak256Hash(publicKey, owner.Bytes())
+}
+
+// NewValidatorRegistry creates an empty ValidatorRegistry, which has to be attached to a NonceCounter to receive events.
+func NewValidatorRegistry() *ValidatorRegistry {
+	return &ValidatorRegistry{
+		validators: map[common.Hash]*Validator{},
+	}
+}
+
+// Attach subscribes the validator registry to the validator lifecycle events of nc. The counter's own handler runs
+// first, so the nonce of a tracked owner's registration is the increment nc recorded for the log.
+func (vr *ValidatorRegistry) Attach(nc *NonceCounter) error {
+	r := nc.Registry()
+	if err := Handle(r, "ValidatorAdded", func(e *SSVNetworkValidatorAdded, vLog types.Log) {
+		increment, tracked := nc.incrementOf(vLog)
+
+		vr.mu.Lock()
+		defer vr.mu.Unlock()
+
+		vr.validators[validatorID(e.PublicKey, e.Owner)] = &Validator{
+			PublicKey:   slices.Clone(e.PublicKey),
+			Owner:       e.Owner,
+			OperatorIds: slices.Clone(e.OperatorIds),
+			AddedBlock:  vLog.BlockNumber,
+			AddedTx:     vLog.TxHash,
+			Tracked:     tracked,
+			Nonce:       increment.Nonce,
+			Status:      ValidatorActive,
+			StatusBlock: vLog.BlockNumber,
+		}
+	}); err != nil {
+		return err
+	}
+	if err := Handle(r, "ValidatorExited", func(e *SSVNetworkValidatorExited, vLog types.Log) {
+		vr.setStatus(e.PublicKey, e.Owner, ValidatorExited, vLog.BlockNumber)
+	}); err != nil {
+		return err
+	}
+	return Handle(r, "ValidatorRemoved", func(e *SSVNetworkValidatorRemoved, vLog types.Log) {
+		vr.setStatus(e.PublicKey, e.Owner, ValidatorRemoved, vLog.BlockNumber)
+	})
+}
+
+// Validator returns the indexed state of the validator with the given BLS public key registered by owner, if it ever
+// was.
+func (vr *ValidatorRegistry) Validator(publicKey []byte, owner common.Address) (Validator, bool) {
+	vr.mu.RLock()
+	defer vr.mu.RUnlock()
+
+	validator, ok := vr.validators[validatorID(publicKey, owner)]
+	if !ok {
+		return Validator{}, false
+	}
+	return validator.clone(), true
+}
+
+// ValidatorsOf returns every validator registered by owner in registration order, which is the order of the nonces
+// their registrations consumed.
+func (vr *ValidatorRegistry) ValidatorsOf(owner common.Address) []Validator {
+	vr.mu.RLock()
+	defer vr.mu.RUnlock()
+
+	var validators []Validator
+	for _, validator := range vr.validators {
+		if validator.Owner == owner {
+			validators = append(validators, validator.clone())
+		}
+	}
+	slices.SortFunc(validators, func(a, b Validator) int {
+		return cmp.Or(cmp.Compare(a.AddedBlock, b.AddedBlock), cmp.Compare(a.Nonce, b.Nonce))
+	})
+	return validators
+}
+
+// CheckRegistration returns ErrValidatorRegistered if owner registering the validator with the given BLS public key
+// would be rejected by the contract, as owner registered it and did not remove it since. Registrations of the same
+// public key by other owners do not conflict, the contract keys validators by public key and owner.
+func (vr *ValidatorRegistry) CheckRegistration(publicKey []byte, owner common.Address) error {
+	validator, ok := vr.Validator(publicKey, owner)
+	if !ok || validator.Status == ValidatorRemoved {
+		return nil
+	}
+	return fmt.Errorf("%w: %s is %s, owned by %s since block %d", ErrValidatorRegistered,
+		hexutil.Encode(publicKey), validator.Status, validator.Owner.Hex(), validator.AddedBlock)
+}
+
+// setStatus updates the status of a validator of owner, validators registered before the scan started are ignored.
+func (vr *ValidatorRegistry) setStatus(publicKey []byte, owner common.Address, status ValidatorStatus, block uint64) {
+	vr.mu.Lock()
+	defer vr.mu.Unlock()
+
+	validator, ok := vr.validators[validatorID(publicKey, owner)]
+	if !ok {
+		return
+	}
+	validator.Status = status
+	validator.StatusBlock = block
+}
+
+// clone returns a deep copy of v so the state handed out is not affected by later events.
+func (v *Validator) clone() Validator {
+	c := *v
+	c.PublicKey = slices.Clone(v.PublicKey)
+	c.OperatorIds = slices.Clone(v.OperatorIds)
+	return c
+}
@@ -0,0 +1,115 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestValidatorRegistry(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	untracked := common.HexToAddress("0x1234567890AbcdEF1234567890aBcdef12345678")
+	ownerTopic := []common.Hash{common.BytesToHash(owner.Bytes())}
+	untrackedTopic := []common.Hash{common.BytesToHash(untracked.Bytes())}
+	operators := []uint64{1, 2, 3, 4}
+	cluster := ISSVNetworkCoreCluster{ValidatorCount: 1, Balance: big.NewInt(0)}
+
+	atBlock := func(vLog types.Log, block uint64) types.Log {
+		vLog.BlockNumber = block
+		vLog.TxHash = common.BigToHash(new(big.Int).SetUint64(block))
+		return vLog
+	}
+	logs := []types.Log{
+		atBlock(newEventLog(t, contractAbi, "ValidatorAdded", ownerTopic, operators, []byte{0x01}, []byte{}, cluster), 10),
+		atBlock(newEventLog(t, contractAbi, "ValidatorAdded", ownerTopic, operators, []byte{0x02}, []byte{}, cluster), 11),
+		atBlock(newEventLog(t, contractAbi, "ValidatorAdded", ownerTopic, operators, []byte{0x03}, []byte{}, cluster), 12),
+		atBlock(newEventLog(t, contractAbi, "ValidatorExited", ownerTopic, operators, []byte{0x02}), 13),
+		atBlock(newEventLog(t, contractAbi, "ValidatorRemoved", ownerTopic, operators, []byte{0x03}, cluster), 14),
+		atBlock(newEventLog(t, contractAbi, "ValidatorRemoved", ownerTopic, operators, []byte{0x04}, cluster), 15),
+		atBlock(newEventLog(t, contractAbi, "ValidatorAdded", untrackedTopic, operators, []byte{0x05}, []byte{}, cluster), 16),
+		// The contract keys validators by public key and owner, another owner may register the same public key
+		atBlock(newEventLog(t, contractAbi, "ValidatorAdded", untrackedTopic, operators, []byte{0x01}, []byte{}, cluster), 17),
+		atBlock(newEventLog(t, contractAbi, "ValidatorRemoved", untrackedTopic, operators, []byte{0x02}, cluster), 18),
+	}
+
+	// The counter resumed from a checkpoint, the registrations consume the nonces it counts from there
+	validators := NewValidatorRegistry()
+	nc := newReplayNonceCounter(t, &bytes.Buffer{}, owner)
+	if err := nc.restoreNonces(map[string]uint64{owner.Hex(): 5}, 9, true); err != nil {
+		t.Fatalf("restoreNonces() error = %v", err)
+	}
+	if err := validators.Attach(nc); err != nil {
+		t.Fatalf("Attach() error = %v", err)
+	}
+	if _, err := nc.FindNonces(context.Background(), logs); err != nil {
+		t.Fatalf("FindNonces() error = %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		publicKey       []byte
+		owner           common.Address
+		wantFound       bool
+		wantTracked     bool
+		wantNonce       uint64
+		wantStatus      ValidatorStatus
+		wantAddedBlock  uint64
+		wantStatusBlock uint64
+		wantRegistered  bool
+	}{
+		{name: "active", publicKey: []byte{0x01}, owner: owner, wantFound: true, wantTracked: true, wantNonce: 5, wantStatus: ValidatorActive, wantAddedBlock: 10, wantStatusBlock: 10, wantRegistered: true},
+		{name: "exited", publicKey: []byte{0x02}, owner: owner, wantFound: true, wantTracked: true, wantNonce: 6, wantStatus: ValidatorExited, wantAddedBlock: 11, wantStatusBlock: 13, wantRegistered: true},
+		{name: "removed", publicKey: []byte{0x03}, owner: owner, wantFound: true, wantTracked: true, wantNonce: 7, wantStatus: ValidatorRemoved, wantAddedBlock: 12, wantStatusBlock: 14},
+		{name: "untracked owner", publicKey: []byte{0x05}, owner: untracked, wantFound: true, wantStatus: ValidatorActive, wantAddedBlock: 16, wantStatusBlock: 16, wantRegistered: true},
+		{name: "same public key of another owner", publicKey: []byte{0x01}, owner: untracked, wantFound: true, wantStatus: ValidatorActive, wantAddedBlock: 17, wantStatusBlock: 17, wantRegistered: true},
+		{name: "public key registered by another owner only", publicKey: []byte{0x05}, owner: owner},
+		{name: "unknown", publicKey: []byte{0x04}, owner: owner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, ok := validators.Validator(tt.publicKey, tt.owner)
+			if ok != tt.wantFound {
+				t.Fatalf("Validator() found = %v, want %v", ok, tt.wantFound)
+			}
+			if ok {
+				if validator.Owner != tt.owner {
+					t.Errorf("Owner = %s, want %s", validator.Owner.Hex(), tt.owner.Hex())
+				}
+				if validator.Tracked != tt.wantTracked {
+					t.Errorf("Tracked = %v, want %v", validator.Tracked, tt.wantTracked)
+				}
+				if validator.Nonce != tt.wantNonce {
+					t.Errorf("Nonce = %d, want %d", validator.Nonce, tt.wantNonce)
+				}
+				if validator.Status != tt.wantStatus {
+					t.Errorf("Status = %s, want %s", validator.Status, tt.wantStatus)
+				}
+				if validator.AddedBlock != tt.wantAddedBlock {
+					t.Errorf("AddedBlock = %d, want %d", validator.AddedBlock, tt.wantAddedBlock)
+				}
+				if validator.AddedTx != common.BigToHash(new(big.Int).SetUint64(tt.wantAddedBlock)) {
+					t.Errorf("AddedTx = %s, want the tx of block %d", validator.AddedTx.Hex(), tt.wantAddedBlock)
+				}
+				if validator.StatusBlock != tt.wantStatusBlock {
+					t.Errorf("StatusBlock = %d, want %d", validator.StatusBlock, tt.wantStatusBlock)
+				}
+			}
+
+			err := validators.CheckRegistration(tt.publicKey, tt.owner)
+			if errors.Is(err, ErrValidatorRegistered) != tt.wantRegistered {
+				t.Errorf("CheckRegistration() error = %v, wantRegistered %v", err, tt.wantRegistered)
+			}
+		})
+	}
+
+	if got := validators.ValidatorsOf(owner); len(got) != 3 || got[0].Nonce != 5 || got[2].Nonce != 7 {
+		t.Errorf("ValidatorsOf() = %v, want 3 validators sorted by nonce", got)
+	}
+}
@@ -0,0 +1,64 @@
+package noncecounter
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// watcher receives the nonce increments recorded from history index from on.
+type watcher struct {
+	increments chan Increment
+	from       int
+}
+
+// Watch registers a watcher of the nonce increments of the tracked owners. It returns the current nonce of every
+// tracked owner, the channel every later increment is sent on once its block range is processed, and a function to
+// stop watching. A watcher that lets more than buffer increments pile up is dropped and its channel closed, so slow
+// consumers never stall the scan.
+func (nc *NonceCounter) Watch(buffer int) (map[common.Address]uint64, <-chan Increment, func()) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	nonces := make(map[common.Address]uint64, len(nc.addressToNonce))
+	for address, nonce := range nc.addressToNonce {
+		nonces[common.HexToAddress(address)] = nonce
+	}
+
+	// The increments already recorded are part of the nonces above, even when their block range is still processing
+	w := &watcher{increments: make(chan Increment, buffer), from: len(nc.history)}
+	if nc.watchers == nil {
+		nc.watchers = map[*watcher]struct{}{}
+	}
+	nc.watchers[w] = struct{}{}
+
+	stop := func() {
+		nc.mu.Lock()
+		defer nc.mu.Unlock()
+
+		if _, ok := nc.watchers[w]; ok {
+			delete(nc.watchers, w)
+			close(w.increments)
+		}
+	}
+	return nonces, w.increments, stop
+}
+
+// notifyWatchers sends the increments recorded from history index from on to every watcher.
+func (nc *NonceCounter) notifyWatchers(from int) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	for w := range nc.watchers {
+		for _, increment := range nc.history[max(from, w.from):] {
+			select {
+			case w.increments <- increment:
+			default:
+				nc.Logger().Warn("dropping nonce watcher, it fell behind", "buffer", cap(w.increments))
+				delete(nc.watchers, w)
+				close(w.increments)
+			}
+			if _, ok := nc.watchers[w]; !ok {
+				break
+			}
+		}
+	}
+}
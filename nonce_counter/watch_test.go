@@ -0,0 +1,60 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestWatch(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	nc := newReplayNonceCounter(t, &bytes.Buffer{}, owner)
+	nc.addressToNonce[owner.Hex()] = 5
+
+	process := func(block uint64, n int) {
+		t.Helper()
+		var logs []types.Log
+		for i := 0; i < n; i++ {
+			vLog := newValidatorAddedLog(t, contractAbi, owner)
+			vLog.BlockNumber = block
+			vLog.Index = uint(i)
+			logs = append(logs, vLog)
+		}
+		if err := nc.processBatch(context.Background(), Batch{FromBlock: block, ToBlock: block, Logs: n}, logs, nil); err != nil {
+			t.Fatalf("processBatch() error = %v", err)
+		}
+	}
+
+	nonces, increments, stop := nc.Watch(2)
+	defer stop()
+	slow, slowIncrements, stopSlow := nc.Watch(1)
+	if nonces[owner] != 5 || slow[owner] != 5 {
+		t.Fatalf("Watch() nonces = %v, %v, want %d", nonces, slow, 5)
+	}
+
+	process(10, 2)
+	for _, want := range []uint64{5, 6} {
+		if increment := <-increments; increment.Nonce != want || increment.BlockNumber != 10 {
+			t.Errorf("increment = %v, want nonce %d at block 10", increment, want)
+		}
+	}
+
+	// The slow watcher received the first increment and was dropped on the second
+	if increment, ok := <-slowIncrements; !ok || increment.Nonce != 5 {
+		t.Errorf("slow watcher increment = %v, %v, want nonce 5", increment, ok)
+	}
+	if _, ok := <-slowIncrements; ok {
+		t.Errorf("slow watcher was not dropped")
+	}
+	stopSlow()
+
+	stop()
+	process(11, 1)
+	if _, ok := <-increments; ok {
+		t.Errorf("stopped watcher received an increment")
+	}
+}
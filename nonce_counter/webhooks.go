@@ -0,0 +1,414 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// WebhookEventType is the kind of a webhook notification.
+type WebhookEventType string
+
+// Webhook notification types.
+const (
+	// WebhookNonceIncremented is sent for every nonce a tracked owner consumes.
+	WebhookNonceIncremented WebhookEventType = "nonce_incremented"
+	// WebhookSyncStalled is sent once when no block range was processed for StallTimeout.
+	WebhookSyncStalled WebhookEventType = "sync_stalled"
+	// WebhookDecodeError is sent for every log of a registered event that fails to decode.
+	WebhookDecodeError WebhookEventType = "decode_error"
+)
+
+// WebhookSignatureHeader is the header carrying the hex HMAC-SHA256 of the request body, prefixed with "sha256=".
+const WebhookSignatureHeader = "X-Signature-256"
+
+// Default webhook settings.
+const (
+	DefaultWebhookStallTimeout = 5 * time.Minute
+	DefaultWebhookMinBackoff   = time.Second
+	DefaultWebhookMaxBackoff   = 5 * time.Minute
+)
+
+// WebhookConfig configures a WebhookNotifier.
+type WebhookConfig struct {
+	// URL receives the notifications as JSON POST requests.
+	URL string
+	// Secret signs the request bodies with HMAC-SHA256 in the WebhookSignatureHeader, unsigned when empty.
+	Secret string
+	// OutboxPath persists the notifications not delivered yet, so they are delivered after a restart. Notifications
+	// are only kept in memory when empty.
+	OutboxPath string
+	// StallTimeout is the time without a processed block range after which a stall is notified,
+	// DefaultWebhookStallTimeout when zero.
+	StallTimeout time.Duration
+	// MinBackoff and MaxBackoff bound the exponential backoff between failed deliveries, DefaultWebhookMinBackoff and
+	// DefaultWebhookMaxBackoff when zero.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// Client sends the requests, a client with a 30 second timeout when nil.
+	Client *http.Client
+}
+
+// WebhookEvent is the JSON body of a webhook notification. Events are delivered at least once and in order, their ID
+// is stable across retries and restarts so receivers can drop duplicates.
+type WebhookEvent struct {
+	ID   string           `json:"id"`
+	Type WebhookEventType `json:"type"`
+	Time time.Time        `json:"time"`
+	// Increment and NextNonce are set for WebhookNonceIncremented.
+	Increment *IncrementRecord `json:"increment,omitempty"`
+	NextNonce uint64           `json:"nextNonce,omitempty"`
+	// Stall is set for WebhookSyncStalled.
+	Stall *StallRecord `json:"stall,omitempty"`
+	// DecodeError is set for WebhookDecodeError.
+	DecodeError *DecodeErrorRecord `json:"decodeError,omitempty"`
+}
+
+// StallRecord describes a scan that stopped making progress.
+type StallRecord struct {
+	// LastBlock is the last block processed, valid when Processed is set.
+	LastBlock uint64 `json:"lastBlock"`
+	Processed bool   `json:"processed"`
+	// Since is the time the last block range was processed, or the notifier attached when none was.
+	Since time.Time `json:"since"`
+}
+
+// DecodeErrorRecord describes a log that failed to decode.
+type DecodeErrorRecord struct {
+	Event       string `json:"event"`
+	BlockNumber uint64 `json:"blockNumber"`
+	TxHash      string `json:"txHash"`
+	LogIndex    uint   `json:"logIndex"`
+	Error       string `json:"error"`
+}
+
+// WebhookNotifier posts the nonce increments, sync stalls and decode errors of a NonceCounter to a webhook.
+// Notifications are queued in an outbox and delivered one at a time, in order, by Run.
+type WebhookNotifier struct {
+	config WebhookConfig
+	nc     *NonceCounter
+	now    func() time.Time
+
+	mu sync.Mutex
+	// outbox holds the notifications not delivered yet, oldest first
+	outbox []WebhookEvent
+	// outboxIDs holds the IDs of the notifications in outbox
+	outboxIDs map[string]bool
+	// unsaved is set while the outbox file misses notifications of outbox
+	unsaved  bool
+	queued   chan struct{}
+	seen     int
+	progress time.Time
+	stalled  bool
+}
+
+// NewWebhookNotifier returns a notifier posting to config.URL, loading the notifications left in its outbox.
+func NewWebhookNotifier(config WebhookConfig) (*WebhookNotifier, error) {
+	if config.URL == "" {
+		return nil, errors.New("webhook URL is required")
+	}
+	if config.StallTimeout <= 0 {
+		config.StallTimeout = DefaultWebhookStallTimeout
+	}
+	if config.MinBackoff <= 0 {
+		config.MinBackoff = DefaultWebhookMinBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = DefaultWebhookMaxBackoff
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	w := &WebhookNotifier{config: config, now: time.Now, outboxIDs: map[string]bool{}, queued: make(chan struct{}, 1)}
+	if err := w.loadOutbox(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Attach queues a notification for every nonce increment and decode error of nc, and tracks its progress to detect
+// stalls. The notifications of a block range are queued as an OnDeliver hook, so the scan checkpoint of nc is withheld
+// until they are saved to the outbox and a counter resumed from it never skips them. It must be called before Start.
+func (w *WebhookNotifier) Attach(nc *NonceCounter) {
+	w.nc = nc
+	w.mu.Lock()
+	w.progress = w.now()
+	w.mu.Unlock()
+
+	nc.OnDeliver(func(Batch) error {
+		w.mu.Lock()
+		seen := w.seen
+		w.mu.Unlock()
+
+		increments := nc.historySince(seen)
+		events := make([]WebhookEvent, 0, len(increments))
+		for _, increment := range increments {
+			record := newIncrementRecord(increment)
+			events = append(events, WebhookEvent{
+				ID:        fmt.Sprintf("nonce-%d-%d", increment.BlockNumber, increment.LogIndex),
+				Type:      WebhookNonceIncremented,
+				Increment: &record,
+				NextNonce: increment.Nonce + 1,
+			})
+		}
+
+		w.mu.Lock()
+		w.seen += len(increments)
+		w.progress = w.now()
+		w.stalled = false
+		w.mu.Unlock()
+		// Retries saving the decode errors of the batch too when their own save failed
+		if err := w.enqueue(events...); err != nil {
+			return fmt.Errorf("failed to save webhook outbox: %w", err)
+		}
+		return nil
+	})
+
+	nc.OnDecodeError(func(failure DecodeError) {
+		// A failed save is retried when the notifications of the block range are queued
+		w.enqueue(WebhookEvent{
+			ID:   fmt.Sprintf("decode-%d-%d", failure.Log.BlockNumber, failure.Log.Index),
+			Type: WebhookDecodeError,
+			DecodeError: &DecodeErrorRecord{
+				Event:       failure.Event,
+				BlockNumber: failure.Log.BlockNumber,
+				TxHash:      failure.Log.TxHash.Hex(),
+				LogIndex:    failure.Log.Index,
+				Error:       failure.Err.Error(),
+			},
+		})
+	})
+}
+
+// logger returns the logger of the attached counter, slog.Default() before Attach.
+func (w *WebhookNotifier) logger() *slog.Logger {
+	if w.nc == nil {
+		return slog.Default()
+	}
+	return w.nc.Logger()
+}
+
+// Pending returns the number of notifications waiting to be delivered.
+func (w *WebhookNotifier) Pending() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return len(w.outbox)
+}
+
+// Run delivers the queued notifications until ctx is done, retrying failed deliveries with exponential backoff. Once
+// attached, it also watches the counter for stalls.
+func (w *WebhookNotifier) Run(ctx context.Context) {
+	if w.nc != nil {
+		go w.watchStalls(ctx)
+	}
+
+	// Delivered notifications are removed from the outbox file once the outbox is drained, a delivery fails or Run
+	// returns, rewriting it after every delivery would be quadratic in the backlog. Notifications delivered since the
+	// last save are delivered again after a crash, which receivers already handle.
+	delivered := false
+	save := func() {
+		if !delivered {
+			return
+		}
+		w.mu.Lock()
+		err := w.saveOutbox()
+		w.unsaved = err != nil
+		w.mu.Unlock()
+		if err != nil {
+			w.logger().Error("failed to save webhook outbox", "error", err)
+			return
+		}
+		delivered = false
+	}
+	defer save()
+
+	backoff := w.config.MinBackoff
+	for {
+		w.mu.Lock()
+		var next WebhookEvent
+		ok := len(w.outbox) > 0
+		if ok {
+			next = w.outbox[0]
+		}
+		w.mu.Unlock()
+
+		if !ok {
+			save()
+			select {
+			case <-ctx.Done():
+				return
+			case <-w.queued:
+			}
+			continue
+		}
+
+		if err := w.deliver(ctx, next); err != nil {
+			w.logger().Warn("failed to deliver webhook, retrying", "id", next.ID, "type", next.Type, "retry_in", backoff,
+				"error", err)
+			save()
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, w.config.MaxBackoff)
+			continue
+		}
+		backoff = w.config.MinBackoff
+
+		w.mu.Lock()
+		delete(w.outboxIDs, next.ID)
+		w.outbox = w.outbox[1:]
+		w.mu.Unlock()
+		delivered = true
+	}
+}
+
+// deliver posts event to the webhook, succeeding on any 2xx response.
+func (w *WebhookNotifier) deliver(ctx context.Context, event WebhookEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-ID", event.ID)
+	if w.config.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, SignWebhook(w.config.Secret, body))
+	}
+
+	resp, err := w.config.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SignWebhook returns the WebhookSignatureHeader value of body signed with secret, for receivers to compare with
+// hmac.Equal.
+func SignWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// enqueue appends the events not queued yet to the outbox and persists it, returning the logged error of a failed save.
+// Without events it only retries a failed save.
+func (w *WebhookNotifier) enqueue(events ...WebhookEvent) error {
+	w.mu.Lock()
+	if len(events) == 0 && !w.unsaved {
+		w.mu.Unlock()
+		return nil
+	}
+
+	for _, event := range events {
+		// Rescanned blocks produce the same IDs as the notifications still waiting for them
+		if w.outboxIDs[event.ID] {
+			continue
+		}
+		if event.Time.IsZero() {
+			event.Time = w.now().UTC()
+		}
+		w.outbox = append(w.outbox, event)
+		w.outboxIDs[event.ID] = true
+	}
+	err := w.saveOutbox()
+	w.unsaved = err != nil
+	w.mu.Unlock()
+	if err != nil {
+		w.logger().Error("failed to save webhook outbox", "error", err)
+	}
+
+	select {
+	case w.queued <- struct{}{}:
+	default:
+	}
+	return err
+}
+
+// watchStalls queues a stall notification whenever the counter processes no block range for StallTimeout, until ctx
+// is done.
+func (w *WebhookNotifier) watchStalls(ctx context.Context) {
+	ticker := time.NewTicker(w.config.StallTimeout / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		w.mu.Lock()
+		since := w.progress
+		stall := !w.stalled && w.now().Sub(since) >= w.config.StallTimeout
+		if stall {
+			w.stalled = true
+		}
+		w.mu.Unlock()
+		if !stall {
+			continue
+		}
+
+		block, processed := w.nc.LastBlock()
+		w.enqueue(WebhookEvent{
+			ID:    fmt.Sprintf("stall-%d", since.UnixNano()),
+			Type:  WebhookSyncStalled,
+			Stall: &StallRecord{LastBlock: block, Processed: processed, Since: since.UTC()},
+		})
+	}
+}
+
+// loadOutbox loads the notifications left in the outbox file, if any.
+func (w *WebhookNotifier) loadOutbox() error {
+	if w.config.OutboxPath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(w.config.OutboxPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read webhook outbox: %w", err)
+	}
+	if err := json.Unmarshal(data, &w.outbox); err != nil {
+		return fmt.Errorf("failed to decode webhook outbox: %w", err)
+	}
+	for _, event := range w.outbox {
+		w.outboxIDs[event.ID] = true
+	}
+	return nil
+}
+
+// saveOutbox persists the outbox, the caller must hold mu.
+func (w *WebhookNotifier) saveOutbox() error {
+	if w.config.OutboxPath == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(w.outbox)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(w.config.OutboxPath, data)
+}
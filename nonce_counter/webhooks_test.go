@@ -0,0 +1,257 @@
+package noncecounter
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// webhookReceiver is an httptest handler recording the webhooks it accepts, failing the first failures requests. It
+// checks the signature of every request against secret, and that requests are unsigned when secret is empty.
+type webhookReceiver struct {
+	t        *testing.T
+	secret   string
+	mu       sync.Mutex
+	failures int
+	received []WebhookEvent
+	arrived  chan struct{}
+}
+
+func newWebhookReceiver(t *testing.T, secret string, failures int) (*webhookReceiver, *httptest.Server) {
+	receiver := &webhookReceiver{t: t, secret: secret, failures: failures, arrived: make(chan struct{}, 100)}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+	return receiver, server
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	want := ""
+	if r.secret != "" {
+		want = SignWebhook(r.secret, body)
+	}
+	if got := req.Header.Get(WebhookSignatureHeader); !hmac.Equal([]byte(got), []byte(want)) {
+		r.t.Errorf("webhook signature = %q, want %q", got, want)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures > 0 {
+		r.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	var event WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		r.t.Errorf("invalid webhook body %s: %v", body, err)
+	}
+	r.received = append(r.received, event)
+	r.arrived <- struct{}{}
+}
+
+// wait waits for n webhooks to be accepted and returns every accepted webhook.
+func (r *webhookReceiver) wait(n int) []WebhookEvent {
+	r.t.Helper()
+	for i := 0; i < n; i++ {
+		select {
+		case <-r.arrived:
+		case <-time.After(5 * time.Second):
+			r.t.Fatalf("received %d webhooks, want %d", i, n)
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]WebhookEvent(nil), r.received...)
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	receiver, server := newWebhookReceiver(t, "secret", 2)
+
+	nc := newReplayNonceCounter(t, &bytes.Buffer{}, owner)
+	notifier, err := NewWebhookNotifier(WebhookConfig{
+		URL:          server.URL,
+		Secret:       "secret",
+		OutboxPath:   filepath.Join(t.TempDir(), "outbox.json"),
+		StallTimeout: 100 * time.Millisecond,
+		MinBackoff:   time.Millisecond,
+		MaxBackoff:   10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("NewWebhookNotifier() error = %v", err)
+	}
+	notifier.Attach(nc)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go notifier.Run(ctx)
+
+	valid := newValidatorAddedLog(t, contractAbi, owner)
+	valid.BlockNumber = 10
+	malformed := types.Log{Topics: valid.Topics, BlockNumber: 10, Index: 1}
+	if err := nc.processBatch(ctx, Batch{FromBlock: 10, ToBlock: 10, Logs: 2}, []types.Log{valid, malformed}, nil); err != nil {
+		t.Fatalf("processBatch() error = %v", err)
+	}
+
+	// The decode error is queued while the logs are decoded, before the increments of the batch
+	events := receiver.wait(3)
+	if events[0].Type != WebhookDecodeError || events[0].ID != "decode-10-1" || events[0].DecodeError.Event != "ValidatorAdded" {
+		t.Errorf("first webhook = %+v, want the decode error", events[0])
+	}
+	if events[1].Type != WebhookNonceIncremented || events[1].ID != "nonce-10-0" || events[1].Increment.Owner != owner.Hex() ||
+		events[1].Increment.Nonce != 0 || events[1].NextNonce != 1 {
+		t.Errorf("second webhook = %+v, want the increment", events[1])
+	}
+	// No block range is processed after the first one, so a single stall is reported
+	if events[2].Type != WebhookSyncStalled || events[2].Stall.LastBlock != 10 || !events[2].Stall.Processed {
+		t.Errorf("third webhook = %+v, want a stall at block 10", events[2])
+	}
+	// The outbox is emptied once the last delivery is acknowledged
+	deadline := time.Now().Add(5 * time.Second)
+	for notifier.Pending() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Pending() = %d, want 0", notifier.Pending())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWebhookOutboxSurvivesRestart(t *testing.T) {
+	receiver, server := newWebhookReceiver(t, "", 0)
+	outbox := filepath.Join(t.TempDir(), "outbox.json")
+	config := WebhookConfig{URL: server.URL, OutboxPath: outbox, MinBackoff: time.Millisecond}
+
+	// Notifications queued by a notifier that never delivered them
+	stopped, err := NewWebhookNotifier(config)
+	if err != nil {
+		t.Fatalf("NewWebhookNotifier() error = %v", err)
+	}
+	stopped.enqueue(WebhookEvent{ID: "a"}, WebhookEvent{ID: "b"}, WebhookEvent{ID: "a"})
+	if pending := stopped.Pending(); pending != 2 {
+		t.Fatalf("Pending() = %d, want 2 once duplicates are dropped", pending)
+	}
+
+	restarted, err := NewWebhookNotifier(config)
+	if err != nil {
+		t.Fatalf("NewWebhookNotifier() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go restarted.Run(ctx)
+
+	events := receiver.wait(2)
+	if events[0].ID != "a" || events[1].ID != "b" {
+		t.Errorf("delivered webhooks = %+v, want a then b", events)
+	}
+
+	// Delivered notifications are removed from the outbox file
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		reloaded, err := NewWebhookNotifier(config)
+		if err != nil {
+			t.Fatalf("NewWebhookNotifier() error = %v", err)
+		}
+		if reloaded.Pending() == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("outbox still holds %d notifications", reloaded.Pending())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWebhookNotifierWithholdsStoreCheckpoint(t *testing.T) {
+	contractAbi := mustParseABI(t, SSVNetworkMetaData.ABI)
+	owner := common.HexToAddress("0xabCDEF1234567890ABcDEF1234567890aBCDeF12")
+	dir := t.TempDir()
+	storePath, outbox := filepath.Join(dir, "nonces.db"), filepath.Join(dir, "outbox.json")
+
+	logs := map[uint64][]types.Log{}
+	for i, block := range []uint64{10, 20} {
+		vLog := newValidatorAddedLog(t, contractAbi, owner)
+		vLog.BlockNumber = block
+		vLog.Index = uint(i)
+		logs[block] = append(logs[block], vLog)
+	}
+	// start attaches a reopened store before a notifier that never delivers, and returns the block to resume from
+	start := func() (*NonceCounter, *SQLStore, *WebhookNotifier, uint64) {
+		t.Helper()
+		store, err := OpenSQLStore(storePath)
+		if err != nil {
+			t.Fatalf("OpenSQLStore() error = %v", err)
+		}
+		t.Cleanup(func() { store.Close() })
+		nc := newReplayNonceCounter(t, &bytes.Buffer{}, owner)
+		resume, _, err := store.Attach(nc)
+		if err != nil {
+			t.Fatalf("SQLStore.Attach() error = %v", err)
+		}
+		notifier, err := NewWebhookNotifier(WebhookConfig{URL: "http://127.0.0.1:1", OutboxPath: outbox})
+		if err != nil {
+			t.Fatalf("NewWebhookNotifier() error = %v", err)
+		}
+		notifier.Attach(nc)
+		return nc, store, notifier, resume
+	}
+
+	nc, store, _, _ := start()
+	if err := nc.processBatch(context.Background(), Batch{FromBlock: 10, ToBlock: 10}, logs[10], nil); err != nil {
+		t.Fatalf("processBatch() error = %v", err)
+	}
+	// A directory in place of the outbox makes saving the notifications of block 20 fail when the process stops
+	if err := os.Rename(outbox, outbox+".bak"); err != nil {
+		t.Fatalf("failed to move outbox: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(outbox, "blocked"), 0o755); err != nil {
+		t.Fatalf("failed to block outbox: %v", err)
+	}
+	if err := nc.processBatch(context.Background(), Batch{FromBlock: 20, ToBlock: 20}, logs[20], nil); err != nil {
+		t.Fatalf("processBatch() error = %v", err)
+	}
+	if block, ok, err := store.Checkpoint(context.Background()); err != nil || !ok || block != 10 {
+		t.Fatalf("store Checkpoint() = %d, %v, %v, want 10, true", block, ok, err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := os.RemoveAll(outbox); err != nil {
+		t.Fatalf("failed to unblock outbox: %v", err)
+	}
+	if err := os.Rename(outbox+".bak", outbox); err != nil {
+		t.Fatalf("failed to restore outbox: %v", err)
+	}
+
+	// The restarted counter resumes from the store, before the lost notification, and queues it again
+	nc, store, notifier, resume := start()
+	if resume != 11 || notifier.Pending() != 1 {
+		t.Fatalf("resumed at block %d with %d notifications, want block 11 and 1", resume, notifier.Pending())
+	}
+	if err := nc.processBatch(context.Background(), Batch{FromBlock: 11, ToBlock: 20}, logs[20], nil); err != nil {
+		t.Fatalf("processBatch() error = %v", err)
+	}
+	if block, ok, err := store.Checkpoint(context.Background()); err != nil || !ok || block != 20 {
+		t.Errorf("store Checkpoint() after restart = %d, %v, %v, want 20, true", block, ok, err)
+	}
+	reloaded, err := NewWebhookNotifier(WebhookConfig{URL: "http://127.0.0.1:1", OutboxPath: outbox})
+	if err != nil {
+		t.Fatalf("NewWebhookNotifier() error = %v", err)
+	}
+	reloaded.mu.Lock()
+	defer reloaded.mu.Unlock()
+	if len(reloaded.outbox) != 2 || reloaded.outbox[0].ID != "nonce-10-0" || reloaded.outbox[1].ID != "nonce-20-1" {
+		t.Errorf("saved outbox = %+v, want the notifications of blocks 10 and 20", reloaded.outbox)
+	}
+}